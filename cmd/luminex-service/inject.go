@@ -4,6 +4,9 @@ import (
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/log"
 	"luminex-service/internal/biz"
+	"luminex-service/internal/biz/alerting"
+	"luminex-service/internal/biz/digest"
+	"luminex-service/internal/biz/exporter"
 	gh "luminex-service/internal/biz/github"
 	"luminex-service/internal/conf"
 	svr "luminex-service/internal/server"
@@ -12,15 +15,35 @@ import (
 
 func injectApp(config *conf.Bootstrap, logger log.Logger) (*kratos.App, error) {
 	ghConfigs := service.ProvideGithubConfigs(config)
-	ghHandler := gh.NewGithubHandler(logger, ghConfigs)
+	analyticsConfig := service.ProvideAnalyticsConfig(config)
+	alertingConfig := service.ProvideAlertingConfig(config)
+	digestsConfig := service.ProvideDigestsConfig(config)
+	metricsConfig := service.ProvideMetricsConfig(config)
+	ghHandler, err := gh.NewGithubHandler(logger, ghConfigs, analyticsConfig)
+	if err != nil {
+		return nil, err
+	}
+	alertEngine, err := alerting.NewEngine(logger, alertingConfig, ghHandler)
+	if err != nil {
+		return nil, err
+	}
+	digestScheduler, err := digest.NewScheduler(logger, digestsConfig, ghHandler)
+	if err != nil {
+		return nil, err
+	}
+	metricsExporter, err := exporter.NewExporter(logger, metricsConfig, ghHandler)
+	if err != nil {
+		return nil, err
+	}
 	iLuminexHandler := biz.NewLuminexServiceHandler(logger)
 	luminexService := service.NewLuminexService(
 		iLuminexHandler,
 		ghHandler,
+		digestScheduler,
 		logger,
 	)
 	grpcServer := svr.NewGRPCServer(config, luminexService, logger)
-	httpServer := svr.NewHTTPServer(config, luminexService, logger)
-	app := newApp(logger, httpServer, grpcServer)
+	httpServer := svr.NewHTTPServer(config, luminexService, metricsExporter, logger)
+	app := newApp(logger, httpServer, grpcServer, alertEngine, digestScheduler, metricsExporter)
 	return app, nil
 }
@@ -4,6 +4,9 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"luminex-service/internal/biz/alerting"
+	"luminex-service/internal/biz/digest"
+	"luminex-service/internal/biz/exporter"
 	"luminex-service/internal/conf"
 	"os"
 	"sync"
@@ -25,7 +28,7 @@ func init() {
 	flag.StringVar(&flagconf, "conf", "configs/", "config path, eg: -conf configs/")
 }
 
-func newApp(logger log.Logger, hs *http.Server, gs *grpc.Server) *kratos.App {
+func newApp(logger log.Logger, hs *http.Server, gs *grpc.Server, alerts *alerting.Engine, digests *digest.Scheduler, metrics *exporter.Exporter) *kratos.App {
 	return kratos.New(
 		kratos.ID(id),
 		kratos.Name(Name),
@@ -35,6 +38,9 @@ func newApp(logger log.Logger, hs *http.Server, gs *grpc.Server) *kratos.App {
 		kratos.Server(
 			hs,
 			gs,
+			alerts,
+			digests,
+			metrics,
 		),
 	)
 }
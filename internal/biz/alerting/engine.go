@@ -0,0 +1,297 @@
+// Package alerting evaluates the configured alert rules on a schedule and notifies sinks when
+// rules start or stop firing.
+package alerting
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"luminex-service/internal/conf"
+	"luminex-service/internal/helpers/notify"
+	"luminex-service/internal/helpers/telemetry"
+)
+
+const (
+	defaultInterval       = 5 * time.Minute
+	defaultRepeatInterval = 4 * time.Hour
+
+	StatusFiring   = "firing"
+	StatusResolved = "resolved"
+
+	timestampLayout = "2006-01-02T15:04:05Z07:00"
+	syncName        = "alert_rules"
+)
+
+// MetricSource measures the metric of a rule; it reports false when there is no data.
+type MetricSource interface {
+	MeasureMetric(owner, repo, team, metric string, windowDays int32) (float64, bool, error)
+}
+
+// Alert is the notification payload, also sent as the body of generic webhooks.
+type Alert struct {
+	Rule        string  `json:"rule"`
+	Status      string  `json:"status"`
+	Severity    string  `json:"severity,omitempty"`
+	Description string  `json:"description,omitempty"`
+	Owner       string  `json:"owner,omitempty"`
+	Repo        string  `json:"repo,omitempty"`
+	Team        string  `json:"team,omitempty"`
+	Metric      string  `json:"metric"`
+	Comparator  string  `json:"comparator"`
+	Threshold   float64 `json:"threshold"`
+	Value       float64 `json:"value"`
+	StartedAt   string  `json:"started_at"`
+	ResolvedAt  string  `json:"resolved_at,omitempty"`
+}
+
+type alertState struct {
+	firing       bool
+	startedAt    time.Time
+	lastNotified time.Time
+}
+
+type silence struct {
+	rules    []string
+	startsAt time.Time
+	endsAt   time.Time
+}
+
+func (s *silence) covers(rule string, now time.Time) bool {
+	if now.Before(s.startsAt) || !now.Before(s.endsAt) {
+		return false
+	}
+	for _, r := range s.rules {
+		if r == "*" || r == rule {
+			return true
+		}
+	}
+	return false
+}
+
+// Engine runs as a kratos server so it starts and stops with the application.
+type Engine struct {
+	rules          []*conf.AlertRule
+	sinks          map[string]notify.Sink
+	silences       []*silence
+	interval       time.Duration
+	repeatInterval time.Duration
+	source         MetricSource
+	log            *log.Helper
+
+	mu     sync.Mutex
+	states map[string]*alertState
+	stop   chan struct{}
+	once   sync.Once
+}
+
+func NewEngine(logger log.Logger, config *conf.Alerting, source MetricSource) (*Engine, error) {
+	sinks, err := notify.NewSinks(config.GetSinks())
+	if err != nil {
+		return nil, err
+	}
+
+	e := &Engine{
+		rules:          config.GetRules(),
+		sinks:          sinks,
+		interval:       defaultInterval,
+		repeatInterval: defaultRepeatInterval,
+		source:         source,
+		log:            log.NewHelper(logger),
+		states:         make(map[string]*alertState),
+		stop:           make(chan struct{}),
+	}
+	if config.GetIntervalSeconds() > 0 {
+		e.interval = time.Duration(config.GetIntervalSeconds()) * time.Second
+	}
+	if config.GetRepeatIntervalSeconds() > 0 {
+		e.repeatInterval = time.Duration(config.GetRepeatIntervalSeconds()) * time.Second
+	}
+
+	names := make(map[string]bool)
+	for _, rule := range e.rules {
+		if rule.GetName() == "" || names[rule.GetName()] {
+			return nil, fmt.Errorf("alert rules need unique names, got %q", rule.GetName())
+		}
+		names[rule.GetName()] = true
+		if _, err := conf.Compare(rule.GetComparator(), 0, 0); err != nil {
+			return nil, fmt.Errorf("alert rule %q: %w", rule.GetName(), err)
+		}
+		if err := conf.ValidateMetric(rule.GetMetric()); err != nil {
+			return nil, fmt.Errorf("alert rule %q: %w", rule.GetName(), err)
+		}
+		for _, sink := range rule.GetSinks() {
+			if sinks[sink] == nil {
+				return nil, fmt.Errorf("alert rule %q: unknown sink %q", rule.GetName(), sink)
+			}
+		}
+	}
+
+	for _, s := range config.GetSilences() {
+		startsAt, err := time.Parse(time.RFC3339, s.GetStartsAt())
+		if err != nil {
+			return nil, fmt.Errorf("invalid silence starts_at %q: %w", s.GetStartsAt(), err)
+		}
+		endsAt, err := time.Parse(time.RFC3339, s.GetEndsAt())
+		if err != nil {
+			return nil, fmt.Errorf("invalid silence ends_at %q: %w", s.GetEndsAt(), err)
+		}
+		e.silences = append(e.silences, &silence{rules: s.GetRules(), startsAt: startsAt, endsAt: endsAt})
+	}
+
+	return e, nil
+}
+
+func (e *Engine) Start(ctx context.Context) error {
+	if len(e.rules) == 0 {
+		e.log.Info("No alert rules configured, alerting is disabled")
+		return nil
+	}
+	e.log.Infof("Evaluating %d alert rules every %s", len(e.rules), e.interval)
+	for _, rule := range e.rules {
+		telemetry.RegisterSync(syncName, rule.GetName())
+	}
+
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+	for {
+		e.Evaluate(ctx)
+		select {
+		case <-ticker.C:
+		case <-e.stop:
+			return nil
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (e *Engine) Stop(ctx context.Context) error {
+	e.once.Do(func() { close(e.stop) })
+	return nil
+}
+
+// Evaluate runs every rule once and sends the resulting notifications.
+func (e *Engine) Evaluate(ctx context.Context) {
+	for _, rule := range e.rules {
+		value, measured, err := e.source.MeasureMetric(rule.GetOwner(), rule.GetRepo(), rule.GetTeam(), rule.GetMetric(), rule.GetWindowDays())
+		if err != nil {
+			e.log.Errorf("Failed to evaluate alert rule %s: %v", rule.GetName(), err)
+			continue
+		}
+		telemetry.SyncSucceeded(syncName, rule.GetName())
+		if !measured {
+			continue
+		}
+		crossed, _ := conf.Compare(rule.GetComparator(), value, rule.GetThreshold())
+		if alert := e.transition(rule, value, crossed, time.Now()); alert != nil {
+			e.notify(ctx, rule, alert)
+		}
+	}
+}
+
+// transition updates the rule state and returns the alert to send, if any. Firing alerts are sent
+// once and repeated every repeat interval; a resolve is sent only for alerts that were notified.
+// Silences suppress firing notifications but not resolves, so receivers that saw an alert fire
+// always see it resolve.
+func (e *Engine) transition(rule *conf.AlertRule, value float64, crossed bool, now time.Time) *Alert {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	state := e.states[rule.GetName()]
+	if state == nil {
+		state = &alertState{}
+		e.states[rule.GetName()] = state
+	}
+	silenced := e.silenced(rule.GetName(), now)
+
+	alert := &Alert{
+		Rule:        rule.GetName(),
+		Severity:    rule.GetSeverity(),
+		Description: rule.GetDescription(),
+		Owner:       rule.GetOwner(),
+		Repo:        rule.GetRepo(),
+		Team:        rule.GetTeam(),
+		Metric:      rule.GetMetric(),
+		Comparator:  rule.GetComparator(),
+		Threshold:   rule.GetThreshold(),
+		Value:       value,
+	}
+
+	switch {
+	case crossed:
+		if !state.firing {
+			state.firing = true
+			state.startedAt = now
+			state.lastNotified = time.Time{}
+		}
+		if silenced || (!state.lastNotified.IsZero() && now.Sub(state.lastNotified) < e.repeatInterval) {
+			return nil
+		}
+		state.lastNotified = now
+		alert.Status = StatusFiring
+		alert.StartedAt = state.startedAt.Format(timestampLayout)
+		return alert
+
+	case state.firing:
+		notified := !state.lastNotified.IsZero()
+		alert.StartedAt = state.startedAt.Format(timestampLayout)
+		*state = alertState{}
+		if !notified {
+			return nil
+		}
+		alert.Status = StatusResolved
+		alert.ResolvedAt = now.Format(timestampLayout)
+		return alert
+	}
+	return nil
+}
+
+func (e *Engine) silenced(rule string, now time.Time) bool {
+	for _, s := range e.silences {
+		if s.covers(rule, now) {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *Engine) notify(ctx context.Context, rule *conf.AlertRule, alert *Alert) {
+	message := &notify.Message{
+		Subject: fmt.Sprintf("[%s] %s", strings.ToUpper(alert.Status), alert.Rule),
+		Text:    alertText(alert),
+		Data:    alert,
+	}
+
+	names := rule.GetSinks()
+	if len(names) == 0 {
+		for name := range e.sinks {
+			names = append(names, name)
+		}
+	}
+	for _, name := range names {
+		if err := e.sinks[name].Send(ctx, message); err != nil {
+			e.log.Errorf("Failed to send alert %s to %s: %v", alert.Rule, name, err)
+		}
+	}
+}
+
+func alertText(alert *Alert) string {
+	target := alert.Owner + "/" + alert.Repo
+	if alert.Team != "" {
+		target += " (team " + alert.Team + ")"
+	}
+	text := fmt.Sprintf("%s on %s: %s is %.2f (%s %.2f)", alert.Rule, target, alert.Metric, alert.Value, alert.Comparator, alert.Threshold)
+	if alert.Description != "" {
+		text += "\n" + alert.Description
+	}
+	if alert.Status == StatusResolved {
+		text += fmt.Sprintf("\nFiring since %s, resolved at %s", alert.StartedAt, alert.ResolvedAt)
+	} else {
+		text += "\nFiring since " + alert.StartedAt
+	}
+	return text
+}
@@ -0,0 +1,230 @@
+package alerting
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"luminex-service/internal/conf"
+	"luminex-service/internal/helpers/notify"
+)
+
+type fakeSource struct {
+	values []float64
+	err    error
+	calls  int
+}
+
+func (f *fakeSource) MeasureMetric(owner, repo, team, metric string, windowDays int32) (float64, bool, error) {
+	if f.err != nil {
+		return 0, false, f.err
+	}
+	value := f.values[f.calls]
+	f.calls++
+	return value, true, nil
+}
+
+type recordingSink struct {
+	mu       sync.Mutex
+	messages []*notify.Message
+}
+
+func (s *recordingSink) Name() string {
+	return "recorder"
+}
+
+func (s *recordingSink) Send(ctx context.Context, message *notify.Message) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.messages = append(s.messages, message)
+	return nil
+}
+
+var t0 = time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+func testRule() *conf.AlertRule {
+	return &conf.AlertRule{Name: "open-prs", Owner: "o", Repo: "r", Metric: "open_prs", Comparator: "gt", Threshold: 5}
+}
+
+func TestTransition(t *testing.T) {
+	type step struct {
+		at      time.Duration
+		crossed bool
+		want    string
+	}
+	silence := &conf.Silence{
+		Rules:    []string{"open-prs"},
+		StartsAt: t0.Add(time.Hour).Format(time.RFC3339),
+		EndsAt:   t0.Add(2 * time.Hour).Format(time.RFC3339),
+	}
+
+	tests := []struct {
+		name     string
+		silences []*conf.Silence
+		steps    []step
+	}{
+		{
+			name: "fires once and deduplicates",
+			steps: []step{
+				{at: 0, crossed: true, want: StatusFiring},
+				{at: 5 * time.Minute, crossed: true},
+				{at: time.Hour, crossed: true},
+			},
+		},
+		{
+			name: "repeats after the repeat interval",
+			steps: []step{
+				{at: 0, crossed: true, want: StatusFiring},
+				{at: 3*time.Hour + 59*time.Minute, crossed: true},
+				{at: 4 * time.Hour, crossed: true, want: StatusFiring},
+				{at: 5 * time.Hour, crossed: true},
+			},
+		},
+		{
+			name: "resolves once and fires again",
+			steps: []step{
+				{at: 0, crossed: true, want: StatusFiring},
+				{at: 5 * time.Minute, crossed: false, want: StatusResolved},
+				{at: 10 * time.Minute, crossed: false},
+				{at: 15 * time.Minute, crossed: true, want: StatusFiring},
+			},
+		},
+		{
+			name:  "never fires below the threshold",
+			steps: []step{{at: 0}, {at: time.Hour}},
+		},
+		{
+			name:     "silence suppresses firing until it ends",
+			silences: []*conf.Silence{silence},
+			steps: []step{
+				{at: 90 * time.Minute, crossed: true},
+				{at: 100 * time.Minute, crossed: true},
+				{at: 2 * time.Hour, crossed: true, want: StatusFiring},
+			},
+		},
+		{
+			name:     "silenced alert that never notified resolves quietly",
+			silences: []*conf.Silence{silence},
+			steps: []step{
+				{at: 90 * time.Minute, crossed: true},
+				{at: 100 * time.Minute, crossed: false},
+			},
+		},
+		{
+			name:     "notified alert still resolves during a silence",
+			silences: []*conf.Silence{silence},
+			steps: []step{
+				{at: 30 * time.Minute, crossed: true, want: StatusFiring},
+				{at: 90 * time.Minute, crossed: true},
+				{at: 100 * time.Minute, crossed: false, want: StatusResolved},
+			},
+		},
+		{
+			name:     "wildcard silence covers every rule",
+			silences: []*conf.Silence{{Rules: []string{"*"}, StartsAt: silence.StartsAt, EndsAt: silence.EndsAt}},
+			steps:    []step{{at: 90 * time.Minute, crossed: true}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := testRule()
+			e, err := NewEngine(log.DefaultLogger, &conf.Alerting{Rules: []*conf.AlertRule{rule}, Silences: tt.silences}, &fakeSource{})
+			if err != nil {
+				t.Fatalf("NewEngine() error = %v", err)
+			}
+			for i, s := range tt.steps {
+				var got string
+				if alert := e.transition(rule, 0, s.crossed, t0.Add(s.at)); alert != nil {
+					got = alert.Status
+				}
+				if got != s.want {
+					t.Errorf("step %d at %s: status = %q, want %q", i, s.at, got, s.want)
+				}
+			}
+		})
+	}
+}
+
+func TestTransitionTimestamps(t *testing.T) {
+	rule := testRule()
+	e, err := NewEngine(log.DefaultLogger, &conf.Alerting{Rules: []*conf.AlertRule{rule}}, &fakeSource{})
+	if err != nil {
+		t.Fatalf("NewEngine() error = %v", err)
+	}
+	e.transition(rule, 8, true, t0)
+	repeated := e.transition(rule, 9, true, t0.Add(4*time.Hour))
+	if repeated.StartedAt != t0.Format(timestampLayout) || repeated.Value != 9 {
+		t.Errorf("repeat = %+v, want started at %s with value 9", repeated, t0.Format(timestampLayout))
+	}
+	resolved := e.transition(rule, 2, false, t0.Add(5*time.Hour))
+	if resolved.StartedAt != t0.Format(timestampLayout) || resolved.ResolvedAt != t0.Add(5*time.Hour).Format(timestampLayout) {
+		t.Errorf("resolve = %+v, want started at %s and resolved 5h later", resolved, t0.Format(timestampLayout))
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	tests := []struct {
+		name   string
+		source *fakeSource
+		want   []string
+	}{
+		{
+			name:   "fires, deduplicates and resolves",
+			source: &fakeSource{values: []float64{8, 9, 3}},
+			want:   []string{"[FIRING] open-prs", "[RESOLVED] open-prs"},
+		},
+		{
+			name:   "measurement errors send nothing",
+			source: &fakeSource{err: errors.New("rate limited")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, err := NewEngine(log.DefaultLogger, &conf.Alerting{Rules: []*conf.AlertRule{testRule()}}, tt.source)
+			if err != nil {
+				t.Fatalf("NewEngine() error = %v", err)
+			}
+			sink := &recordingSink{}
+			e.sinks = map[string]notify.Sink{sink.Name(): sink}
+
+			for i := 0; i < 3; i++ {
+				e.Evaluate(context.Background())
+			}
+			if len(sink.messages) != len(tt.want) {
+				t.Fatalf("sent %d messages, want %d", len(sink.messages), len(tt.want))
+			}
+			for i, message := range sink.messages {
+				if message.Subject != tt.want[i] {
+					t.Errorf("message %d subject = %q, want %q", i, message.Subject, tt.want[i])
+				}
+				if _, ok := message.Data.(*Alert); !ok {
+					t.Errorf("message %d data = %T, want *Alert", i, message.Data)
+				}
+			}
+		})
+	}
+}
+
+func TestNewEngineValidation(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *conf.Alerting
+	}{
+		{name: "duplicate rule", config: &conf.Alerting{Rules: []*conf.AlertRule{testRule(), testRule()}}},
+		{name: "unknown comparator", config: &conf.Alerting{Rules: []*conf.AlertRule{{Name: "x", Comparator: "eq"}}}},
+		{name: "unknown sink", config: &conf.Alerting{Rules: []*conf.AlertRule{{Name: "x", Comparator: "gt", Sinks: []string{"missing"}}}}},
+		{name: "invalid silence", config: &conf.Alerting{Silences: []*conf.Silence{{StartsAt: "tomorrow"}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewEngine(log.DefaultLogger, tt.config, &fakeSource{}); err == nil {
+				t.Error("NewEngine() error = nil, want an error")
+			}
+		})
+	}
+}
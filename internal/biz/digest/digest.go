@@ -0,0 +1,130 @@
+// Package digest builds the weekly engineering digests, renders them to Markdown and HTML,
+// delivers them to the configured sinks and keeps the most recent ones for the digests RPC.
+package digest
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"os"
+	texttemplate "text/template"
+	"time"
+)
+
+//go:embed templates
+var defaultTemplates embed.FS
+
+type MergedPR struct {
+	Number int
+	Title  string
+	Author string
+	URL    string
+}
+
+type Reviewer struct {
+	Username  string
+	Reviews   int32
+	Approvals int32
+}
+
+type StalePR struct {
+	Number       int32
+	Title        string
+	Author       string
+	URL          string
+	DaysInactive int32
+	Reasons      string
+}
+
+type CIHealth struct {
+	Runs           int32
+	SuccessRate    float32 // percentage, 0-100
+	MedianDuration string
+}
+
+// Data is the content of one digest, as exposed to the templates.
+type Data struct {
+	Target       string
+	Owner        string
+	Repo         string
+	Team         string
+	PeriodStart  time.Time
+	PeriodEnd    time.Time
+	MergedPRs    []*MergedPR
+	TopReviewers []*Reviewer
+	StalePRs     []*StalePR
+	IssuesOpened int
+	IssuesClosed int
+	OpenIssues   int
+	CI           *CIHealth
+}
+
+// BacklogChange is the net change of open issues over the period.
+func (d *Data) BacklogChange() int {
+	return d.IssuesOpened - d.IssuesClosed
+}
+
+type renderer struct {
+	markdown *texttemplate.Template
+	html     *htmltemplate.Template
+}
+
+var templateFuncs = map[string]interface{}{
+	"date":    func(t time.Time) string { return t.Format("2006-01-02") },
+	"percent": func(rate float32) string { return fmt.Sprintf("%.1f%%", rate) },
+	"signed": func(n int) string {
+		if n > 0 {
+			return fmt.Sprintf("+%d", n)
+		}
+		return fmt.Sprint(n)
+	},
+}
+
+// newRenderer loads the Markdown and HTML templates from the given files, falling back to the
+// built-in templates for any path left empty.
+func newRenderer(markdownPath, htmlPath string) (*renderer, error) {
+	markdownSource, err := templateSource(markdownPath, "templates/digest.md.tmpl")
+	if err != nil {
+		return nil, err
+	}
+	htmlSource, err := templateSource(htmlPath, "templates/digest.html.tmpl")
+	if err != nil {
+		return nil, err
+	}
+
+	markdown, err := texttemplate.New("markdown").Funcs(templateFuncs).Parse(markdownSource)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse markdown template: %w", err)
+	}
+	html, err := htmltemplate.New("html").Funcs(templateFuncs).Parse(htmlSource)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse html template: %w", err)
+	}
+	return &renderer{markdown: markdown, html: html}, nil
+}
+
+func templateSource(path, fallback string) (string, error) {
+	var content []byte
+	var err error
+	if path != "" {
+		content, err = os.ReadFile(path)
+	} else {
+		content, err = defaultTemplates.ReadFile(fallback)
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to read digest template: %w", err)
+	}
+	return string(content), nil
+}
+
+func (r *renderer) render(data *Data) (string, string, error) {
+	var markdown, html bytes.Buffer
+	if err := r.markdown.Execute(&markdown, data); err != nil {
+		return "", "", fmt.Errorf("failed to render markdown digest: %w", err)
+	}
+	if err := r.html.Execute(&html, data); err != nil {
+		return "", "", fmt.Errorf("failed to render html digest: %w", err)
+	}
+	return markdown.String(), html.String(), nil
+}
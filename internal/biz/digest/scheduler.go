@@ -0,0 +1,352 @@
+package digest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"luminex-service/internal/conf"
+	"luminex-service/internal/helpers/notify"
+	"luminex-service/internal/helpers/telemetry"
+)
+
+const (
+	defaultWeekday = time.Monday
+	defaultHour    = 9
+	defaultHistory = 12
+
+	digestPeriod  = 7 * 24 * time.Hour
+	checkInterval = time.Minute
+
+	timestampLayout = "2006-01-02T15:04:05Z07:00"
+	syncName        = "digests"
+)
+
+// Source gathers the data of one digest.
+type Source interface {
+	BuildDigest(ctx context.Context, owner, repo, team string, start, end time.Time) (*Data, error)
+}
+
+// Digest is a rendered digest, also sent as the body of generic webhooks.
+type Digest struct {
+	Target      string `json:"target"`
+	PeriodStart string `json:"period_start"`
+	PeriodEnd   string `json:"period_end"`
+	GeneratedAt string `json:"generated_at"`
+	Markdown    string `json:"markdown"`
+	HTML        string `json:"html"`
+}
+
+// Scheduler runs as a kratos server so it starts and stops with the application. It builds the
+// digests of every target once a week and keeps the most recent ones, in memory and in the store
+// file when one is configured.
+type Scheduler struct {
+	targets   []*conf.DigestTarget
+	sinks     map[string]notify.Sink
+	weekday   time.Weekday
+	hour      int
+	location  *time.Location
+	history   int
+	storePath string
+	renderer  *renderer
+	source    Source
+	log       *log.Helper
+
+	mu      sync.Mutex
+	digests map[string][]*Digest
+	lastRun time.Time
+	stop    chan struct{}
+	once    sync.Once
+}
+
+func NewScheduler(logger log.Logger, config *conf.Digests, source Source) (*Scheduler, error) {
+	sinks, err := notify.NewSinks(config.GetSinks())
+	if err != nil {
+		return nil, err
+	}
+	renderer, err := newRenderer(config.GetMarkdownTemplate(), config.GetHtmlTemplate())
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Scheduler{
+		targets:   config.GetTargets(),
+		sinks:     sinks,
+		weekday:   defaultWeekday,
+		hour:      defaultHour,
+		location:  time.UTC,
+		history:   defaultHistory,
+		storePath: config.GetStorePath(),
+		renderer:  renderer,
+		source:    source,
+		log:       log.NewHelper(logger),
+		digests:   make(map[string][]*Digest),
+		stop:      make(chan struct{}),
+	}
+	if config.GetWeekday() != "" {
+		if s.weekday, err = parseWeekday(config.GetWeekday()); err != nil {
+			return nil, err
+		}
+	}
+	if config != nil && config.Hour != nil {
+		if config.GetHour() < 0 || config.GetHour() > 23 {
+			return nil, fmt.Errorf("invalid digest hour %d, expected 0-23", config.GetHour())
+		}
+		s.hour = int(config.GetHour())
+	}
+	if config.GetTimezone() != "" {
+		if s.location, err = time.LoadLocation(config.GetTimezone()); err != nil {
+			return nil, fmt.Errorf("invalid digest timezone %q: %w", config.GetTimezone(), err)
+		}
+	}
+	if config.GetHistory() > 0 {
+		s.history = int(config.GetHistory())
+	}
+
+	names := make(map[string]bool)
+	for _, target := range s.targets {
+		if target.GetName() == "" || names[target.GetName()] {
+			return nil, fmt.Errorf("digest targets need unique names, got %q", target.GetName())
+		}
+		names[target.GetName()] = true
+		if target.GetOwner() == "" || target.GetRepo() == "" {
+			return nil, fmt.Errorf("digest target %q: owner and repo are required", target.GetName())
+		}
+		for _, sink := range target.GetSinks() {
+			if sinks[sink] == nil {
+				return nil, fmt.Errorf("digest target %q: unknown sink %q", target.GetName(), sink)
+			}
+		}
+	}
+	if err := s.load(); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func parseWeekday(day string) (time.Weekday, error) {
+	for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+		if strings.EqualFold(day, weekday.String()) || strings.EqualFold(day, weekday.String()[:3]) {
+			return weekday, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown digest weekday %q", day)
+}
+
+// scheduledAt returns the most recent scheduled run at or before now.
+func (s *Scheduler) scheduledAt(now time.Time) time.Time {
+	local := now.In(s.location)
+	run := time.Date(local.Year(), local.Month(), local.Day(), s.hour, 0, 0, 0, s.location)
+	run = run.AddDate(0, 0, -((int(local.Weekday()) - int(s.weekday) + 7) % 7))
+	if run.After(local) {
+		run = run.AddDate(0, 0, -7)
+	}
+	return run
+}
+
+// Start waits for the next scheduled run; runs missed while the service was down are not caught up.
+func (s *Scheduler) Start(ctx context.Context) error {
+	if len(s.targets) == 0 {
+		s.log.Info("No digest targets configured, digests are disabled")
+		return nil
+	}
+	s.lastRun = s.scheduledAt(time.Now())
+	for _, target := range s.targets {
+		telemetry.RegisterSync(syncName, target.GetName())
+	}
+	s.log.Infof("Sending %d weekly digests on %s at %02d:00 %s", len(s.targets), s.weekday, s.hour, s.location)
+
+	ticker := time.NewTicker(checkInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case now := <-ticker.C:
+			if run := s.scheduledAt(now); run.After(s.lastRun) {
+				s.lastRun = run
+				s.Run(ctx, run)
+			}
+		case <-s.stop:
+			return nil
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (s *Scheduler) Stop(ctx context.Context) error {
+	s.once.Do(func() { close(s.stop) })
+	return nil
+}
+
+// Run builds, stores and sends the digest of every target for the week ending at end.
+func (s *Scheduler) Run(ctx context.Context, end time.Time) {
+	start := end.Add(-digestPeriod)
+	for _, target := range s.targets {
+		d, err := s.build(ctx, target, start, end)
+		if err != nil {
+			s.log.Errorf("Failed to build digest %s: %v", target.GetName(), err)
+			continue
+		}
+		s.store(d)
+		telemetry.SyncSucceeded(syncName, target.GetName())
+		s.send(ctx, target, d)
+	}
+}
+
+func (s *Scheduler) build(ctx context.Context, target *conf.DigestTarget, start, end time.Time) (*Digest, error) {
+	data, err := s.source.BuildDigest(ctx, target.GetOwner(), target.GetRepo(), target.GetTeam(), start, end)
+	if err != nil {
+		return nil, err
+	}
+	data.Target = target.GetName()
+	data.PeriodStart = start.In(s.location)
+	data.PeriodEnd = end.In(s.location)
+
+	markdown, html, err := s.renderer.render(data)
+	if err != nil {
+		return nil, err
+	}
+	return &Digest{
+		Target:      target.GetName(),
+		PeriodStart: data.PeriodStart.Format(timestampLayout),
+		PeriodEnd:   data.PeriodEnd.Format(timestampLayout),
+		GeneratedAt: time.Now().In(s.location).Format(timestampLayout),
+		Markdown:    markdown,
+		HTML:        html,
+	}, nil
+}
+
+func (s *Scheduler) store(d *Digest) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	digests := append([]*Digest{d}, s.digests[d.Target]...)
+	if len(digests) > s.history {
+		digests = digests[:s.history]
+	}
+	s.digests[d.Target] = digests
+	if err := s.save(); err != nil {
+		s.log.Errorf("Failed to save digests: %v", err)
+	}
+}
+
+// load restores the digests kept in the store file, skipping targets that are no longer configured.
+func (s *Scheduler) load() error {
+	if s.storePath == "" {
+		return nil
+	}
+	content, err := os.ReadFile(s.storePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read digest store: %w", err)
+	}
+	var digests map[string][]*Digest
+	if err := json.Unmarshal(content, &digests); err != nil {
+		return fmt.Errorf("failed to parse digest store %s: %w", s.storePath, err)
+	}
+	for target, kept := range digests {
+		if !s.hasTarget(target) {
+			continue
+		}
+		if len(kept) > s.history {
+			kept = kept[:s.history]
+		}
+		s.digests[target] = kept
+	}
+	return nil
+}
+
+// save writes the digests to the store file through a temporary file, so a crash mid-write keeps
+// the previous contents. The caller holds s.mu.
+func (s *Scheduler) save() error {
+	if s.storePath == "" {
+		return nil
+	}
+	content, err := json.Marshal(s.digests)
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(s.storePath), filepath.Base(s.storePath)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(content); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), s.storePath)
+}
+
+func (s *Scheduler) send(ctx context.Context, target *conf.DigestTarget, d *Digest) {
+	message := &notify.Message{
+		Subject: fmt.Sprintf("Weekly digest: %s (%s to %s)", d.Target, d.PeriodStart[:10], d.PeriodEnd[:10]),
+		Text:    d.Markdown,
+		HTML:    d.HTML,
+		Data:    d,
+	}
+
+	names := target.GetSinks()
+	if len(names) == 0 {
+		for name := range s.sinks {
+			names = append(names, name)
+		}
+	}
+	for _, name := range names {
+		if err := s.sinks[name].Send(ctx, message); err != nil {
+			s.log.Errorf("Failed to send digest %s to %s: %v", d.Target, name, err)
+		}
+	}
+}
+
+// Recent returns up to limit of the most recent digests, newest first, for one target or for all
+// targets when target is empty.
+func (s *Scheduler) Recent(target string, limit int) ([]*Digest, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var digests []*Digest
+	if target != "" {
+		if !s.hasTarget(target) {
+			return nil, fmt.Errorf("unknown digest target %q", target)
+		}
+		digests = append(digests, s.digests[target]...)
+	} else {
+		for _, d := range s.digests {
+			digests = append(digests, d...)
+		}
+		sort.SliceStable(digests, func(i, j int) bool {
+			return digests[i].PeriodEnd > digests[j].PeriodEnd
+		})
+	}
+	if limit <= 0 || limit > s.history {
+		limit = s.history
+	}
+	if len(digests) > limit {
+		digests = digests[:limit]
+	}
+	return digests, nil
+}
+
+func (s *Scheduler) hasTarget(name string) bool {
+	for _, target := range s.targets {
+		if target.GetName() == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,199 @@
+// Package exporter keeps Prometheus gauges for the configured repositories up to date and serves
+// them, together with the service telemetry, on the metrics endpoint of the HTTP server.
+package exporter
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"luminex-service/internal/conf"
+	gh "luminex-service/internal/helpers/github"
+	"luminex-service/internal/helpers/telemetry"
+)
+
+const (
+	namespace = "luminex"
+	subsystem = "repository"
+	syncName  = "repository_metrics"
+
+	defaultPath         = "/metrics"
+	defaultSyncInterval = 15 * time.Minute
+	defaultWindowDays   = 30
+)
+
+// Source collects the figures of one repository.
+type Source interface {
+	RepositorySnapshot(owner, repo string, windowDays int32) (*gh.RepositorySnapshot, error)
+}
+
+type repository struct {
+	owner string
+	repo  string
+}
+
+type gauges struct {
+	openPRs       *prometheus.GaugeVec
+	mergedPRs     *prometheus.GaugeVec
+	mergeTime     *prometheus.GaugeVec
+	openIssues    *prometheus.GaugeVec
+	stars         *prometheus.GaugeVec
+	forks         *prometheus.GaugeVec
+	ciSuccessRate *prometheus.GaugeVec
+	lastSync      *prometheus.GaugeVec
+}
+
+// Exporter runs as a kratos server so the background sync starts and stops with the application.
+type Exporter struct {
+	path         string
+	interval     time.Duration
+	windowDays   int32
+	repositories []repository
+	source       Source
+	gauges       *gauges
+	log          *log.Helper
+
+	stop chan struct{}
+	once sync.Once
+}
+
+func NewExporter(logger log.Logger, config *conf.Metrics, source Source) (*Exporter, error) {
+	e := &Exporter{
+		path:       defaultPath,
+		interval:   defaultSyncInterval,
+		windowDays: defaultWindowDays,
+		source:     source,
+		log:        log.NewHelper(logger),
+		stop:       make(chan struct{}),
+	}
+	if config.GetPath() != "" {
+		e.path = config.GetPath()
+	}
+	if config.GetSyncIntervalSeconds() > 0 {
+		e.interval = time.Duration(config.GetSyncIntervalSeconds()) * time.Second
+	}
+	if config.GetWindowDays() > 0 {
+		e.windowDays = config.GetWindowDays()
+	}
+	for _, name := range config.GetRepositories() {
+		owner, repo, ok := strings.Cut(name, "/")
+		if !ok || owner == "" || repo == "" {
+			return nil, fmt.Errorf("invalid metrics repository %q, expected owner/repo", name)
+		}
+		e.repositories = append(e.repositories, repository{owner: owner, repo: repo})
+	}
+
+	e.gauges = newGauges(e.windowDays)
+	for _, collector := range []prometheus.Collector{
+		e.gauges.openPRs,
+		e.gauges.mergedPRs,
+		e.gauges.mergeTime,
+		e.gauges.openIssues,
+		e.gauges.stars,
+		e.gauges.forks,
+		e.gauges.ciSuccessRate,
+		e.gauges.lastSync,
+	} {
+		if err := telemetry.Registry.Register(collector); err != nil {
+			return nil, fmt.Errorf("failed to register repository metrics: %w", err)
+		}
+	}
+	return e, nil
+}
+
+func newGauges(windowDays int32) *gauges {
+	gauge := func(name, help string, labels ...string) *prometheus.GaugeVec {
+		return prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      name,
+			Help:      help,
+		}, append([]string{"owner", "repo"}, labels...))
+	}
+	return &gauges{
+		openPRs:       gauge("open_pull_requests", "Open pull requests."),
+		mergedPRs:     gauge("merged_pull_requests", fmt.Sprintf("Pull requests merged in the last %d days.", windowDays)),
+		mergeTime:     gauge("merge_time_hours", fmt.Sprintf("Time from opening to merge of pull requests merged in the last %d days.", windowDays), "quantile"),
+		openIssues:    gauge("open_issues", "Open issues, excluding pull requests."),
+		stars:         gauge("stars", "Stargazers."),
+		forks:         gauge("forks", "Forks."),
+		ciSuccessRate: gauge("ci_success_ratio", fmt.Sprintf("Share of completed GitHub Actions runs that succeeded in the last %d days, from 0 to 1.", windowDays)),
+		lastSync:      gauge("last_sync_timestamp_seconds", "Unix time of the last successful sync."),
+	}
+}
+
+func (e *Exporter) Path() string {
+	return e.path
+}
+
+func (e *Exporter) Handler() http.Handler {
+	return telemetry.Handler()
+}
+
+func (e *Exporter) Start(ctx context.Context) error {
+	if len(e.repositories) == 0 {
+		e.log.Info("No metrics repositories configured, repository sync is disabled")
+		return nil
+	}
+	e.log.Infof("Syncing metrics for %d repositories every %s", len(e.repositories), e.interval)
+	for _, r := range e.repositories {
+		telemetry.RegisterSync(syncName, r.owner+"/"+r.repo)
+	}
+
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+	for {
+		e.Sync()
+		select {
+		case <-ticker.C:
+		case <-e.stop:
+			return nil
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (e *Exporter) Stop(ctx context.Context) error {
+	e.once.Do(func() { close(e.stop) })
+	return nil
+}
+
+// Sync refreshes the gauges of every repository; a repository that fails keeps its previous values.
+func (e *Exporter) Sync() {
+	for _, r := range e.repositories {
+		snapshot, err := e.source.RepositorySnapshot(r.owner, r.repo, e.windowDays)
+		if err != nil {
+			e.log.Errorf("Failed to sync metrics for %s/%s: %v", r.owner, r.repo, err)
+			continue
+		}
+
+		g := e.gauges
+		g.openPRs.WithLabelValues(r.owner, r.repo).Set(float64(snapshot.OpenPRs))
+		g.mergedPRs.WithLabelValues(r.owner, r.repo).Set(float64(snapshot.MergedPRs))
+		if snapshot.MergedPRs > 0 {
+			g.mergeTime.WithLabelValues(r.owner, r.repo, "0.5").Set(snapshot.MergeTimeP50Hours)
+			g.mergeTime.WithLabelValues(r.owner, r.repo, "0.9").Set(snapshot.MergeTimeP90Hours)
+		} else {
+			// without merges there is no merge time, so drop the series rather than keep a stale value
+			g.mergeTime.DeleteLabelValues(r.owner, r.repo, "0.5")
+			g.mergeTime.DeleteLabelValues(r.owner, r.repo, "0.9")
+		}
+		g.openIssues.WithLabelValues(r.owner, r.repo).Set(float64(snapshot.OpenIssues))
+		g.stars.WithLabelValues(r.owner, r.repo).Set(float64(snapshot.Stars))
+		g.forks.WithLabelValues(r.owner, r.repo).Set(float64(snapshot.Forks))
+		if snapshot.CIRuns > 0 {
+			// Prometheus ratios are 0-1 while the API reports percentages.
+			g.ciSuccessRate.WithLabelValues(r.owner, r.repo).Set(snapshot.CISuccessRate / 100)
+		} else {
+			g.ciSuccessRate.DeleteLabelValues(r.owner, r.repo)
+		}
+		g.lastSync.WithLabelValues(r.owner, r.repo).SetToCurrentTime()
+		telemetry.SyncSucceeded(syncName, r.owner+"/"+r.repo)
+	}
+}
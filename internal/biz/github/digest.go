@@ -0,0 +1,101 @@
+package github
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bikash-789/comm-protos/luminex/v1/request"
+	"github.com/bikash-789/comm-protos/luminex/v1/response"
+	"luminex-service/internal/biz/digest"
+	"luminex-service/internal/conf"
+)
+
+const digestTopReviewers = 5
+
+// BuildDigest gathers the weekly digest data for a repository, or for a team ("org/slug") within it,
+// over the period ending at end.
+func (g *GithubHandler) BuildDigest(ctx context.Context, owner, repo, team string, start, end time.Time) (*digest.Data, error) {
+	g.log.WithContext(ctx).Infof("BuildDigest: owner=%s, repo=%s, team=%s", owner, repo, team)
+	filter, err := g.authorFilter(false)
+	if err != nil {
+		return nil, err
+	}
+	days := int32(end.Sub(start).Hours() / 24)
+
+	activity, err := g.githubHelper.GetWeeklyActivity(owner, repo, team, start, end, filter)
+	if err != nil {
+		return nil, err
+	}
+	member := func(login string) bool {
+		return activity.Members == nil || activity.Members[login]
+	}
+
+	workload, err := g.githubHelper.GetReviewerWorkload(&request.ReviewerWorkloadRequest{Owner: owner, Repo: repo, Days: days}, filter)
+	if err != nil {
+		return nil, err
+	}
+	atRisk, err := g.githubHelper.GetAtRiskPRs(&request.RepositoryRequest{Owner: owner, Repo: repo}, conf.GetStaleThresholds(g.analytics, owner, repo), filter)
+	if err != nil {
+		return nil, err
+	}
+	ci, err := g.githubHelper.GetCISummary(owner, repo, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	data := &digest.Data{
+		Owner:        owner,
+		Repo:         repo,
+		Team:         team,
+		PeriodStart:  start,
+		PeriodEnd:    end,
+		IssuesOpened: activity.IssuesOpened,
+		IssuesClosed: activity.IssuesClosed,
+		OpenIssues:   activity.OpenIssues,
+	}
+	for _, pr := range activity.MergedPRs {
+		data.MergedPRs = append(data.MergedPRs, &digest.MergedPR{
+			Number: pr.GetNumber(),
+			Title:  pr.GetTitle(),
+			Author: pr.GetUser().GetLogin(),
+			URL:    pr.GetHTMLURL(),
+		})
+	}
+
+	var reviewers []*response.ReviewerStats
+	for _, r := range workload.Reviewers {
+		if r.ReviewsSubmitted > 0 && member(r.Username) {
+			reviewers = append(reviewers, r)
+		}
+	}
+	sort.SliceStable(reviewers, func(i, j int) bool {
+		return reviewers[i].ReviewsSubmitted > reviewers[j].ReviewsSubmitted
+	})
+	for i, r := range reviewers {
+		if i == digestTopReviewers {
+			break
+		}
+		data.TopReviewers = append(data.TopReviewers, &digest.Reviewer{Username: r.Username, Reviews: r.ReviewsSubmitted, Approvals: r.Approvals})
+	}
+
+	for _, pr := range atRisk.Prs {
+		if !member(pr.Author) {
+			continue
+		}
+		data.StalePRs = append(data.StalePRs, &digest.StalePR{
+			Number:       pr.Number,
+			Title:        pr.Title,
+			Author:       pr.Author,
+			URL:          pr.Url,
+			DaysInactive: pr.DaysInactive,
+			Reasons:      strings.Join(pr.Reasons, ", "),
+		})
+	}
+
+	if ci.Runs > 0 {
+		data.CI = &digest.CIHealth{Runs: ci.Runs, SuccessRate: ci.SuccessRate, MedianDuration: ci.MedianDuration}
+	}
+	return data, nil
+}
@@ -10,7 +10,27 @@ type IGithubHandler interface {
 	GetPRMetrics(ctx context.Context, req *request.RepositoryRequest) (*response.PRMetricsResponse, error)
 	GetMonthlyStats(ctx context.Context, req *request.RepositoryRequest) (*response.MonthlyStatsResponse, error)
 	GetRepoStats(ctx context.Context, req *request.RepositoryRequest) (*response.RepoStatsResponse, error)
-	GetContributorStats(ctx context.Context, req *request.RepositoryRequest) (*response.ContributorStatsResponse, error)
+	GetContributorStats(ctx context.Context, req *request.ContributorStatsRequest) (*response.ContributorStatsResponse, error)
 	GetIssueStats(ctx context.Context, req *request.RepositoryRequest) (*response.IssueStatsResponse, error)
 	GetDetailedPRMetrics(ctx context.Context, req *request.RepositoryRequest) (*response.DetailedPRStatsResponse, error)
+	GetTeamMembers(ctx context.Context, req *request.TeamRequest) (*response.TeamMembersResponse, error)
+	GetTeamMetrics(ctx context.Context, req *request.TeamMetricsRequest) (*response.TeamMetricsResponse, error)
+	GetReviewerWorkload(ctx context.Context, req *request.ReviewerWorkloadRequest) (*response.ReviewerWorkloadResponse, error)
+	GetAtRiskPRs(ctx context.Context, req *request.RepositoryRequest) (*response.AtRiskPRsResponse, error)
+	GetCIMetrics(ctx context.Context, req *request.CIMetricsRequest) (*response.CIMetricsResponse, error)
+	GetReleaseMetrics(ctx context.Context, req *request.ReleaseMetricsRequest) (*response.ReleaseMetricsResponse, error)
+	GetChangelog(ctx context.Context, req *request.ChangelogRequest) (*response.ChangelogResponse, error)
+	GetLabelBreakdown(ctx context.Context, req *request.LabelBreakdownRequest) (*response.LabelBreakdownResponse, error)
+	GetMilestoneProgress(ctx context.Context, req *request.MilestoneProgressRequest) (*response.MilestoneProgressResponse, error)
+	GetDependencyUpdates(ctx context.Context, req *request.DependencyUpdatesRequest) (*response.DependencyUpdatesResponse, error)
+	GetContributorRetention(ctx context.Context, req *request.ContributorRetentionRequest) (*response.ContributorRetentionResponse, error)
+	GetBusFactor(ctx context.Context, req *request.BusFactorRequest) (*response.BusFactorResponse, error)
+	GetHotspots(ctx context.Context, req *request.HotspotsRequest) (*response.HotspotsResponse, error)
+	GetCodeOwnersCoverage(ctx context.Context, req *request.CodeOwnersCoverageRequest) (*response.CodeOwnersCoverageResponse, error)
+	GetActivityHeatmap(ctx context.Context, req *request.ActivityHeatmapRequest) (*response.ActivityHeatmapResponse, error)
+	GetHygieneReport(ctx context.Context, req *request.HygieneReportRequest) (*response.HygieneReportResponse, error)
+	GetReverts(ctx context.Context, req *request.RevertsRequest) (*response.RevertsResponse, error)
+	GetAnomalies(ctx context.Context, req *request.AnomalyRequest) (*response.AnomalyResponse, error)
+	GetForecast(ctx context.Context, req *request.ForecastRequest) (*response.ForecastResponse, error)
+	GetGoalStatus(ctx context.Context, req *request.GoalStatusRequest) (*response.GoalStatusResponse, error)
 }
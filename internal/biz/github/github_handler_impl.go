@@ -2,35 +2,65 @@ package github
 
 import (
 	"context"
+	"fmt"
 	"github.com/bikash-789/comm-protos/luminex/v1/request"
 	"github.com/bikash-789/comm-protos/luminex/v1/response"
 	"github.com/go-kratos/kratos/v2/log"
+	"luminex-service/internal/conf"
 	gh "luminex-service/internal/helpers/github"
 	"luminex-service/internal/interfaces/entity"
 )
 
 type GithubHandler struct {
 	githubConfig entity.GithubConfig
+	analytics    *conf.Analytics
 	githubHelper *gh.GithubClient
+	goals        *goalTracker
 	log          *log.Helper
 }
 
-func NewGithubHandler(logger log.Logger, githubConfig entity.GithubConfig) *GithubHandler {
+func NewGithubHandler(logger log.Logger, githubConfig entity.GithubConfig, analytics *conf.Analytics) (*GithubHandler, error) {
+	for _, goal := range analytics.GetGoals() {
+		if goal.GetOwner() == "" || goal.GetRepo() == "" {
+			return nil, fmt.Errorf("goal %q: owner and repo are required, team goals are measured on a repository", goal.GetName())
+		}
+		if _, err := conf.Compare(goal.GetComparator(), 0, 0); err != nil {
+			return nil, fmt.Errorf("goal %q: %w", goal.GetName(), err)
+		}
+		if err := conf.ValidateMetric(goal.GetMetric()); err != nil {
+			return nil, fmt.Errorf("goal %q: %w", goal.GetName(), err)
+		}
+	}
+
 	return &GithubHandler{
 		log:          log.NewHelper(logger),
 		githubHelper: gh.NewGithubClient(githubConfig),
+		goals:        newGoalTracker(),
 		githubConfig: githubConfig,
-	}
+		analytics:    analytics,
+	}, nil
+}
+
+func (g *GithubHandler) authorFilter(includeBots bool) (*gh.AuthorFilter, error) {
+	return gh.NewAuthorFilter(conf.GetBotFilter(g.analytics), includeBots)
 }
 
 func (g *GithubHandler) GetPRMetrics(ctx context.Context, req *request.RepositoryRequest) (*response.PRMetricsResponse, error) {
 	g.log.WithContext(ctx).Infof("GetPRMetrics: owner=%s, repo=%s", req.Owner, req.Repo)
-	return g.githubHelper.GetPRMetrics(req)
+	filter, err := g.authorFilter(req.IncludeBots)
+	if err != nil {
+		return nil, err
+	}
+	return g.githubHelper.GetPRMetrics(req, filter)
 }
 
 func (g *GithubHandler) GetMonthlyStats(ctx context.Context, req *request.RepositoryRequest) (*response.MonthlyStatsResponse, error) {
 	g.log.WithContext(ctx).Infof("GetMonthlyStats: owner=%s, repo=%s", req.Owner, req.Repo)
-	return g.githubHelper.GetMonthlyStats(req)
+	filter, err := g.authorFilter(req.IncludeBots)
+	if err != nil {
+		return nil, err
+	}
+	return g.githubHelper.GetMonthlyStats(req, filter)
 }
 
 func (g *GithubHandler) GetRepoStats(ctx context.Context, req *request.RepositoryRequest) (*response.RepoStatsResponse, error) {
@@ -38,17 +68,205 @@ func (g *GithubHandler) GetRepoStats(ctx context.Context, req *request.Repositor
 	return g.githubHelper.GetRepoStats(req)
 }
 
-func (g *GithubHandler) GetContributorStats(ctx context.Context, req *request.RepositoryRequest) (*response.ContributorStatsResponse, error) {
-	g.log.WithContext(ctx).Infof("GetContributorStats: owner=%s, repo=%s", req.Owner, req.Repo)
-	return g.githubHelper.GetContributorStats(req)
+func (g *GithubHandler) GetContributorStats(ctx context.Context, req *request.ContributorStatsRequest) (*response.ContributorStatsResponse, error) {
+	g.log.WithContext(ctx).Infof("GetContributorStats: owner=%s, repo=%s, days=%d, sort_by=%s", req.Owner, req.Repo, req.Days, req.SortBy)
+	filter, err := g.authorFilter(req.IncludeBots)
+	if err != nil {
+		return nil, err
+	}
+	return g.githubHelper.GetContributorStats(req, filter)
 }
 
 func (g *GithubHandler) GetIssueStats(ctx context.Context, req *request.RepositoryRequest) (*response.IssueStatsResponse, error) {
 	g.log.WithContext(ctx).Infof("GetIssueStats: owner=%s, repo=%s", req.Owner, req.Repo)
-	return g.githubHelper.GetIssueStats(req)
+	filter, err := g.authorFilter(req.IncludeBots)
+	if err != nil {
+		return nil, err
+	}
+	return g.githubHelper.GetIssueStats(req, filter)
 }
 
 func (g *GithubHandler) GetDetailedPRMetrics(ctx context.Context, req *request.RepositoryRequest) (*response.DetailedPRStatsResponse, error) {
 	g.log.WithContext(ctx).Infof("GetDetailedPRMetrics: owner=%s, repo=%s", req.Owner, req.Repo)
-	return g.githubHelper.GetDetailedPRMetrics(req)
+	sizeSettings := conf.GetPRSizeSettings(g.analytics, req.Owner, req.Repo)
+	filter, err := g.authorFilter(req.IncludeBots)
+	if err != nil {
+		return nil, err
+	}
+	return g.githubHelper.GetDetailedPRMetrics(req, sizeSettings, filter)
+}
+
+func (g *GithubHandler) GetTeamMembers(ctx context.Context, req *request.TeamRequest) (*response.TeamMembersResponse, error) {
+	g.log.WithContext(ctx).Infof("GetTeamMembers: org=%s, team=%s", req.Org, req.TeamSlug)
+	filter, err := g.authorFilter(req.IncludeBots)
+	if err != nil {
+		return nil, err
+	}
+	return g.githubHelper.GetTeamMembers(req, filter)
+}
+
+func (g *GithubHandler) GetTeamMetrics(ctx context.Context, req *request.TeamMetricsRequest) (*response.TeamMetricsResponse, error) {
+	g.log.WithContext(ctx).Infof("GetTeamMetrics: org=%s, team=%s, repos=%d", req.Org, req.TeamSlug, len(req.Repos))
+	filter, err := g.authorFilter(req.IncludeBots)
+	if err != nil {
+		return nil, err
+	}
+	return g.githubHelper.GetTeamMetrics(req, filter)
+}
+
+func (g *GithubHandler) GetReviewerWorkload(ctx context.Context, req *request.ReviewerWorkloadRequest) (*response.ReviewerWorkloadResponse, error) {
+	g.log.WithContext(ctx).Infof("GetReviewerWorkload: owner=%s, repo=%s", req.Owner, req.Repo)
+	filter, err := g.authorFilter(req.IncludeBots)
+	if err != nil {
+		return nil, err
+	}
+	return g.githubHelper.GetReviewerWorkload(req, filter)
+}
+
+func (g *GithubHandler) GetAtRiskPRs(ctx context.Context, req *request.RepositoryRequest) (*response.AtRiskPRsResponse, error) {
+	g.log.WithContext(ctx).Infof("GetAtRiskPRs: owner=%s, repo=%s", req.Owner, req.Repo)
+	thresholds := conf.GetStaleThresholds(g.analytics, req.Owner, req.Repo)
+	filter, err := g.authorFilter(req.IncludeBots)
+	if err != nil {
+		return nil, err
+	}
+	return g.githubHelper.GetAtRiskPRs(req, thresholds, filter)
+}
+
+func (g *GithubHandler) GetCIMetrics(ctx context.Context, req *request.CIMetricsRequest) (*response.CIMetricsResponse, error) {
+	g.log.WithContext(ctx).Infof("GetCIMetrics: owner=%s, repo=%s, days=%d", req.Owner, req.Repo, req.Days)
+	filter, err := g.authorFilter(req.IncludeBots)
+	if err != nil {
+		return nil, err
+	}
+	return g.githubHelper.GetCIMetrics(req, filter)
+}
+
+func (g *GithubHandler) GetReleaseMetrics(ctx context.Context, req *request.ReleaseMetricsRequest) (*response.ReleaseMetricsResponse, error) {
+	g.log.WithContext(ctx).Infof("GetReleaseMetrics: owner=%s, repo=%s", req.Owner, req.Repo)
+	filter, err := g.authorFilter(req.IncludeBots)
+	if err != nil {
+		return nil, err
+	}
+	return g.githubHelper.GetReleaseMetrics(req, filter)
+}
+
+func (g *GithubHandler) GetChangelog(ctx context.Context, req *request.ChangelogRequest) (*response.ChangelogResponse, error) {
+	g.log.WithContext(ctx).Infof("GetChangelog: owner=%s, repo=%s, from=%s, to=%s", req.Owner, req.Repo, req.FromTag, req.ToTag)
+	filter, err := g.authorFilter(req.IncludeBots)
+	if err != nil {
+		return nil, err
+	}
+	return g.githubHelper.GetChangelog(req, filter)
+}
+
+func (g *GithubHandler) GetLabelBreakdown(ctx context.Context, req *request.LabelBreakdownRequest) (*response.LabelBreakdownResponse, error) {
+	g.log.WithContext(ctx).Infof("GetLabelBreakdown: owner=%s, repo=%s, group_by=%s", req.Owner, req.Repo, req.GroupBy)
+	filter, err := g.authorFilter(req.IncludeBots)
+	if err != nil {
+		return nil, err
+	}
+	return g.githubHelper.GetLabelBreakdown(req, filter)
+}
+
+func (g *GithubHandler) GetMilestoneProgress(ctx context.Context, req *request.MilestoneProgressRequest) (*response.MilestoneProgressResponse, error) {
+	g.log.WithContext(ctx).Infof("GetMilestoneProgress: owner=%s, repo=%s, state=%s", req.Owner, req.Repo, req.State)
+	filter, err := g.authorFilter(req.IncludeBots)
+	if err != nil {
+		return nil, err
+	}
+	return g.githubHelper.GetMilestoneProgress(req, filter)
+}
+
+func (g *GithubHandler) GetDependencyUpdates(ctx context.Context, req *request.DependencyUpdatesRequest) (*response.DependencyUpdatesResponse, error) {
+	g.log.WithContext(ctx).Infof("GetDependencyUpdates: owner=%s, repo=%s, days=%d", req.Owner, req.Repo, req.Days)
+	thresholds := conf.GetStaleThresholds(g.analytics, req.Owner, req.Repo)
+	filter, err := g.authorFilter(req.IncludeBots)
+	if err != nil {
+		return nil, err
+	}
+	return g.githubHelper.GetDependencyUpdates(req, thresholds, filter)
+}
+
+func (g *GithubHandler) GetContributorRetention(ctx context.Context, req *request.ContributorRetentionRequest) (*response.ContributorRetentionResponse, error) {
+	g.log.WithContext(ctx).Infof("GetContributorRetention: owner=%s, repo=%s, months=%d", req.Owner, req.Repo, req.Months)
+	filter, err := g.authorFilter(req.IncludeBots)
+	if err != nil {
+		return nil, err
+	}
+	return g.githubHelper.GetContributorRetention(req, filter)
+}
+
+func (g *GithubHandler) GetBusFactor(ctx context.Context, req *request.BusFactorRequest) (*response.BusFactorResponse, error) {
+	g.log.WithContext(ctx).Infof("GetBusFactor: owner=%s, repo=%s, days=%d", req.Owner, req.Repo, req.Days)
+	filter, err := g.authorFilter(req.IncludeBots)
+	if err != nil {
+		return nil, err
+	}
+	return g.githubHelper.GetBusFactor(req, filter)
+}
+
+func (g *GithubHandler) GetHotspots(ctx context.Context, req *request.HotspotsRequest) (*response.HotspotsResponse, error) {
+	g.log.WithContext(ctx).Infof("GetHotspots: owner=%s, repo=%s, days=%d", req.Owner, req.Repo, req.Days)
+	sizeSettings := conf.GetPRSizeSettings(g.analytics, req.Owner, req.Repo)
+	filter, err := g.authorFilter(req.IncludeBots)
+	if err != nil {
+		return nil, err
+	}
+	return g.githubHelper.GetHotspots(req, sizeSettings, filter)
+}
+
+func (g *GithubHandler) GetCodeOwnersCoverage(ctx context.Context, req *request.CodeOwnersCoverageRequest) (*response.CodeOwnersCoverageResponse, error) {
+	g.log.WithContext(ctx).Infof("GetCodeOwnersCoverage: owner=%s, repo=%s, days=%d", req.Owner, req.Repo, req.Days)
+	filter, err := g.authorFilter(req.IncludeBots)
+	if err != nil {
+		return nil, err
+	}
+	return g.githubHelper.GetCodeOwnersCoverage(req, filter)
+}
+
+func (g *GithubHandler) GetActivityHeatmap(ctx context.Context, req *request.ActivityHeatmapRequest) (*response.ActivityHeatmapResponse, error) {
+	g.log.WithContext(ctx).Infof("GetActivityHeatmap: owner=%s, repo=%s, days=%d, timezone=%s", req.Owner, req.Repo, req.Days, req.Timezone)
+	filter, err := g.authorFilter(req.IncludeBots)
+	if err != nil {
+		return nil, err
+	}
+	return g.githubHelper.GetActivityHeatmap(req, conf.GetWorkingHours(g.analytics), filter)
+}
+
+func (g *GithubHandler) GetHygieneReport(ctx context.Context, req *request.HygieneReportRequest) (*response.HygieneReportResponse, error) {
+	g.log.WithContext(ctx).Infof("GetHygieneReport: owner=%s, repo=%s, days=%d", req.Owner, req.Repo, req.Days)
+	rules := conf.GetHygieneRules(g.analytics, req.Owner, req.Repo)
+	filter, err := g.authorFilter(req.IncludeBots)
+	if err != nil {
+		return nil, err
+	}
+	return g.githubHelper.GetHygieneReport(req, rules, filter)
+}
+
+func (g *GithubHandler) GetReverts(ctx context.Context, req *request.RevertsRequest) (*response.RevertsResponse, error) {
+	g.log.WithContext(ctx).Infof("GetReverts: owner=%s, repo=%s, days=%d", req.Owner, req.Repo, req.Days)
+	filter, err := g.authorFilter(req.IncludeBots)
+	if err != nil {
+		return nil, err
+	}
+	return g.githubHelper.GetReverts(req, filter)
+}
+
+func (g *GithubHandler) GetAnomalies(ctx context.Context, req *request.AnomalyRequest) (*response.AnomalyResponse, error) {
+	g.log.WithContext(ctx).Infof("GetAnomalies: owner=%s, repo=%s, granularity=%s", req.Owner, req.Repo, req.Granularity)
+	filter, err := g.authorFilter(req.IncludeBots)
+	if err != nil {
+		return nil, err
+	}
+	return g.githubHelper.GetAnomalies(req, filter)
+}
+
+func (g *GithubHandler) GetForecast(ctx context.Context, req *request.ForecastRequest) (*response.ForecastResponse, error) {
+	g.log.WithContext(ctx).Infof("GetForecast: owner=%s, repo=%s, items=%d, milestone=%d", req.Owner, req.Repo, req.Items, req.Milestone)
+	filter, err := g.authorFilter(req.IncludeBots)
+	if err != nil {
+		return nil, err
+	}
+	return g.githubHelper.GetForecast(req, filter)
 }
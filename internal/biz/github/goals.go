@@ -0,0 +1,176 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bikash-789/comm-protos/luminex/v1/request"
+	"github.com/bikash-789/comm-protos/luminex/v1/response"
+	"luminex-service/internal/conf"
+)
+
+const (
+	goalPass    = "pass"
+	goalWarn    = "warn"
+	goalFail    = "fail"
+	goalUnknown = "unknown"
+
+	defaultWarnMargin = 0.1
+)
+
+// goalTracker remembers since when each goal has been failing. Goals are only evaluated when their
+// status is requested and breaches are tracked in memory, so a breach starts at the first failing
+// request seen by this process and breach durations restart with the service.
+type goalTracker struct {
+	mu       sync.Mutex
+	started  time.Time
+	breached map[string]time.Time
+}
+
+func newGoalTracker() *goalTracker {
+	return &goalTracker{started: time.Now(), breached: make(map[string]time.Time)}
+}
+
+func (t *goalTracker) observe(key string, failing bool, now time.Time) time.Time {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if !failing {
+		delete(t.breached, key)
+		return time.Time{}
+	}
+	if since, ok := t.breached[key]; ok {
+		return since
+	}
+	t.breached[key] = now
+	return now
+}
+
+func goalKey(goal *conf.Goal) string {
+	return strings.Join([]string{goal.GetName(), goal.GetOwner(), goal.GetRepo(), goal.GetTeam(), goal.GetMetric()}, "|")
+}
+
+// goalOutcome compares value with the target. A goal that is met but within warn_margin
+// (a fraction of the target) of missing it is a warning.
+func goalOutcome(goal *conf.Goal, value float64) (string, error) {
+	margin := goal.GetWarnMargin()
+	if margin <= 0 {
+		margin = defaultWarnMargin
+	}
+	target := goal.GetTarget()
+	buffer := math.Abs(target) * margin
+
+	met, err := conf.Compare(goal.GetComparator(), value, target)
+	if err != nil {
+		return "", fmt.Errorf("goal %q: %w", goal.GetName(), err)
+	}
+	if strings.HasPrefix(goal.GetComparator(), "lt") {
+		buffer = -buffer
+	}
+	comfortable, err := conf.Compare(goal.GetComparator(), value, target+buffer)
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case !met:
+		return goalFail, nil
+	case !comfortable:
+		return goalWarn, nil
+	}
+	return goalPass, nil
+}
+
+func (g *GithubHandler) GetGoalStatus(ctx context.Context, req *request.GoalStatusRequest) (*response.GoalStatusResponse, error) {
+	g.log.WithContext(ctx).Infof("GetGoalStatus: owner=%s, repo=%s, team=%s", req.Owner, req.Repo, req.Team)
+	return g.EvaluateGoals(req.Owner, req.Repo, req.Team)
+}
+
+// EvaluateGoals measures the configured goals, optionally narrowed to a repository or team,
+// and updates their breach tracking.
+func (g *GithubHandler) EvaluateGoals(owner, repo, team string) (*response.GoalStatusResponse, error) {
+	filter, err := g.authorFilter(false)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	result := &response.GoalStatusResponse{
+		EvaluatedAt:         now.Format("2006-01-02T15:04:05Z07:00"),
+		BreachTrackingSince: g.goals.started.Format("2006-01-02T15:04:05Z07:00"),
+	}
+	for _, goal := range g.analytics.GetGoals() {
+		if owner != "" && !strings.EqualFold(goal.GetOwner(), owner) {
+			continue
+		}
+		if repo != "" && !strings.EqualFold(goal.GetRepo(), repo) {
+			continue
+		}
+		if team != "" && !strings.EqualFold(goal.GetTeam(), team) {
+			continue
+		}
+
+		status := &response.GoalStatus{
+			Name:       goal.GetName(),
+			Owner:      goal.GetOwner(),
+			Repo:       goal.GetRepo(),
+			Team:       goal.GetTeam(),
+			Metric:     goal.GetMetric(),
+			Comparator: goal.GetComparator(),
+			Target:     goal.GetTarget(),
+			Status:     goalUnknown,
+		}
+		result.Goals = append(result.Goals, status)
+
+		value, measured, err := g.githubHelper.MeasureMetric(goal.GetOwner(), goal.GetRepo(), goal.GetTeam(), goal.GetMetric(), goal.GetWindowDays(), filter)
+		if err != nil {
+			g.log.Errorf("Failed to measure goal %s: %v", goal.GetName(), err)
+			status.Error = err.Error()
+			result.Unknown++
+			continue
+		}
+		if !measured {
+			result.Unknown++
+			continue
+		}
+
+		outcome, err := goalOutcome(goal, value)
+		if err != nil {
+			status.Error = err.Error()
+			result.Unknown++
+			continue
+		}
+		status.Value = value
+		status.Status = outcome
+		status.Gap = value - goal.GetTarget()
+		if goal.GetTarget() != 0 {
+			status.DeviationPercent = float32(status.Gap / math.Abs(goal.GetTarget()) * 100)
+		}
+
+		since := g.goals.observe(goalKey(goal), outcome == goalFail, now)
+		switch outcome {
+		case goalPass:
+			result.Passing++
+		case goalWarn:
+			result.Warning++
+		case goalFail:
+			result.Failing++
+			status.BreachedSince = since.Format("2006-01-02T15:04:05Z07:00")
+			status.BreachedFor = now.Sub(since).Round(time.Second).String()
+		}
+	}
+
+	return result, nil
+}
+
+// MeasureMetric measures a goal metric for alert rules, excluding bot authors.
+func (g *GithubHandler) MeasureMetric(owner, repo, team, metric string, windowDays int32) (float64, bool, error) {
+	filter, err := g.authorFilter(false)
+	if err != nil {
+		return 0, false, err
+	}
+	return g.githubHelper.MeasureMetric(owner, repo, team, metric, windowDays, filter)
+}
@@ -0,0 +1,14 @@
+package github
+
+import (
+	gh "luminex-service/internal/helpers/github"
+)
+
+// RepositorySnapshot collects the repository gauges exported on the metrics endpoint.
+func (g *GithubHandler) RepositorySnapshot(owner, repo string, windowDays int32) (*gh.RepositorySnapshot, error) {
+	filter, err := g.authorFilter(false)
+	if err != nil {
+		return nil, err
+	}
+	return g.githubHelper.GetRepositorySnapshot(owner, repo, windowDays, filter)
+}
@@ -0,0 +1,177 @@
+package conf
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	defaultInactiveDays   = 7
+	defaultReviewSLAHours = 48
+	defaultLargeDiffLines = 1000
+	defaultSmallMaxLines  = 100
+	defaultMediumMaxLines = 500
+	defaultTimezone       = "UTC"
+	defaultWorkStartHour  = 9
+	defaultWorkEndHour    = 18
+)
+
+// HygieneRuleNames lists the PR hygiene rules in reporting order.
+var HygieneRuleNames = []string{
+	"conventional_title",
+	"linked_issue",
+	"description",
+	"labels",
+	"reviewed",
+	"no_force_push_after_approval",
+}
+
+// MetricNames lists the metrics that goals and alert rules can track.
+var MetricNames = []string{
+	"merge_time_p50_hours",
+	"merge_time_p90_hours",
+	"max_review_wait_hours",
+	"open_issues",
+	"open_prs",
+	"ci_success_rate",
+	"merged_prs_per_week",
+}
+
+var defaultWorkingDays = []string{"monday", "tuesday", "wednesday", "thursday", "friday"}
+
+func GetRepository(analytics *Analytics, owner, repo string) *Repository {
+	for _, repository := range analytics.GetRepositories() {
+		if strings.EqualFold(repository.GetOwner(), owner) && strings.EqualFold(repository.GetRepo(), repo) {
+			return repository
+		}
+	}
+	return nil
+}
+
+// GetStaleThresholds layers the repository thresholds over the global ones, falling back to built-in defaults.
+func GetStaleThresholds(analytics *Analytics, owner, repo string) *StaleThresholds {
+	thresholds := &StaleThresholds{
+		InactiveDays:   defaultInactiveDays,
+		ReviewSlaHours: defaultReviewSLAHours,
+		LargeDiffLines: defaultLargeDiffLines,
+	}
+
+	for _, configured := range []*StaleThresholds{
+		analytics.GetStaleThresholds(),
+		GetRepository(analytics, owner, repo).GetStaleThresholds(),
+	} {
+		if configured.GetInactiveDays() > 0 {
+			thresholds.InactiveDays = configured.GetInactiveDays()
+		}
+		if configured.GetReviewSlaHours() > 0 {
+			thresholds.ReviewSlaHours = configured.GetReviewSlaHours()
+		}
+		if configured.GetLargeDiffLines() > 0 {
+			thresholds.LargeDiffLines = configured.GetLargeDiffLines()
+		}
+	}
+	return thresholds
+}
+
+// GetPRSizeSettings layers the repository size buckets over the global ones; exclusion globs from both levels apply.
+func GetPRSizeSettings(analytics *Analytics, owner, repo string) *PRSizeSettings {
+	settings := &PRSizeSettings{
+		SmallMaxLines:  defaultSmallMaxLines,
+		MediumMaxLines: defaultMediumMaxLines,
+	}
+
+	for _, configured := range []*PRSizeSettings{
+		analytics.GetPrSize(),
+		GetRepository(analytics, owner, repo).GetPrSize(),
+	} {
+		if configured.GetSmallMaxLines() > 0 {
+			settings.SmallMaxLines = configured.GetSmallMaxLines()
+		}
+		if configured.GetMediumMaxLines() > 0 {
+			settings.MediumMaxLines = configured.GetMediumMaxLines()
+		}
+		settings.ExcludeGlobs = append(settings.ExcludeGlobs, configured.GetExcludeGlobs()...)
+	}
+	return settings
+}
+
+var defaultBotLoginPatterns = []string{"*[bot]", "*-bot"}
+
+// GetBotFilter returns the configured automation author filter, or one that matches
+// GitHub "Bot" accounts and the usual bot login suffixes when none is configured.
+func GetBotFilter(analytics *Analytics) *BotFilter {
+	if filter := analytics.GetBotFilter(); filter != nil {
+		return filter
+	}
+	return &BotFilter{LoginPatterns: defaultBotLoginPatterns}
+}
+
+// GetWorkingHours returns the configured working hours, defaulting each unset field to 9:00-18:00 UTC,
+// Monday to Friday.
+func GetWorkingHours(analytics *Analytics) *WorkingHours {
+	hours := &WorkingHours{
+		Timezone:    defaultTimezone,
+		StartHour:   proto.Int32(defaultWorkStartHour),
+		EndHour:     proto.Int32(defaultWorkEndHour),
+		WorkingDays: defaultWorkingDays,
+	}
+
+	configured := analytics.GetWorkingHours()
+	if configured.GetTimezone() != "" {
+		hours.Timezone = configured.GetTimezone()
+	}
+	if configured.StartHour != nil {
+		hours.StartHour = configured.StartHour
+	}
+	if configured.EndHour != nil {
+		hours.EndHour = configured.EndHour
+	}
+	if len(configured.GetWorkingDays()) > 0 {
+		hours.WorkingDays = configured.GetWorkingDays()
+	}
+	return hours
+}
+
+// GetHygieneRules returns the repository hygiene rules, or the global ones, enabling every rule when none are listed.
+func GetHygieneRules(analytics *Analytics, owner, repo string) *HygieneRules {
+	configured := GetRepository(analytics, owner, repo).GetHygiene()
+	if configured == nil {
+		configured = analytics.GetHygiene()
+	}
+
+	rules := &HygieneRules{
+		Rules:             configured.GetRules(),
+		ConventionalTypes: configured.GetConventionalTypes(),
+	}
+	if len(rules.Rules) == 0 {
+		rules.Rules = HygieneRuleNames
+	}
+	return rules
+}
+
+// ValidateMetric reports an error unless metric is one of MetricNames.
+func ValidateMetric(metric string) error {
+	for _, name := range MetricNames {
+		if name == metric {
+			return nil
+		}
+	}
+	return fmt.Errorf("unsupported metric %q, expected one of %s", metric, strings.Join(MetricNames, ", "))
+}
+
+// Compare applies a goal or alert rule comparator (lt, lte, gt, gte) to value and target.
+func Compare(comparator string, value, target float64) (bool, error) {
+	switch comparator {
+	case "lt":
+		return value < target, nil
+	case "lte":
+		return value <= target, nil
+	case "gt":
+		return value > target, nil
+	case "gte":
+		return value >= target, nil
+	}
+	return false, fmt.Errorf("unsupported comparator %q, expected lt, lte, gt or gte", comparator)
+}
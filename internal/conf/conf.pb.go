@@ -25,6 +25,10 @@ type Bootstrap struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Server        *Server                `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
 	Logger        *Logger                `protobuf:"bytes,6,opt,name=logger,proto3" json:"logger,omitempty"`
+	Analytics     *Analytics             `protobuf:"bytes,7,opt,name=analytics,proto3" json:"analytics,omitempty"`
+	Alerting      *Alerting              `protobuf:"bytes,8,opt,name=alerting,proto3" json:"alerting,omitempty"`
+	Digests       *Digests               `protobuf:"bytes,9,opt,name=digests,proto3" json:"digests,omitempty"`
+	Metrics       *Metrics               `protobuf:"bytes,10,opt,name=metrics,proto3" json:"metrics,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -73,28 +77,1031 @@ func (x *Bootstrap) GetLogger() *Logger {
 	return nil
 }
 
+func (x *Bootstrap) GetAnalytics() *Analytics {
+	if x != nil {
+		return x.Analytics
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetAlerting() *Alerting {
+	if x != nil {
+		return x.Alerting
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetDigests() *Digests {
+	if x != nil {
+		return x.Digests
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetMetrics() *Metrics {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
 type Logger struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Level         string                 `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
+	Level         string                 `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Logger) Reset() {
+	*x = Logger{}
+	mi := &file_conf_conf_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Logger) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Logger) ProtoMessage() {}
+
+func (x *Logger) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Logger.ProtoReflect.Descriptor instead.
+func (*Logger) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Logger) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+type Server struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	Http                     *Server_HTTP           `protobuf:"bytes,1,opt,name=http,proto3" json:"http,omitempty"`
+	Grpc                     *Server_GRPC           `protobuf:"bytes,2,opt,name=grpc,proto3" json:"grpc,omitempty"`
+	GithubSecretFileLocation string                 `protobuf:"bytes,3,opt,name=github_secret_file_location,json=githubSecretFileLocation,proto3" json:"github_secret_file_location,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *Server) Reset() {
+	*x = Server{}
+	mi := &file_conf_conf_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server) ProtoMessage() {}
+
+func (x *Server) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server.ProtoReflect.Descriptor instead.
+func (*Server) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Server) GetHttp() *Server_HTTP {
+	if x != nil {
+		return x.Http
+	}
+	return nil
+}
+
+func (x *Server) GetGrpc() *Server_GRPC {
+	if x != nil {
+		return x.Grpc
+	}
+	return nil
+}
+
+func (x *Server) GetGithubSecretFileLocation() string {
+	if x != nil {
+		return x.GithubSecretFileLocation
+	}
+	return ""
+}
+
+type Analytics struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	StaleThresholds *StaleThresholds       `protobuf:"bytes,1,opt,name=stale_thresholds,json=staleThresholds,proto3" json:"stale_thresholds,omitempty"`
+	Repositories    []*Repository          `protobuf:"bytes,2,rep,name=repositories,proto3" json:"repositories,omitempty"`
+	PrSize          *PRSizeSettings        `protobuf:"bytes,3,opt,name=pr_size,json=prSize,proto3" json:"pr_size,omitempty"`
+	BotFilter       *BotFilter             `protobuf:"bytes,4,opt,name=bot_filter,json=botFilter,proto3" json:"bot_filter,omitempty"`
+	WorkingHours    *WorkingHours          `protobuf:"bytes,5,opt,name=working_hours,json=workingHours,proto3" json:"working_hours,omitempty"`
+	Hygiene         *HygieneRules          `protobuf:"bytes,6,opt,name=hygiene,proto3" json:"hygiene,omitempty"`
+	Goals           []*Goal                `protobuf:"bytes,7,rep,name=goals,proto3" json:"goals,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Analytics) Reset() {
+	*x = Analytics{}
+	mi := &file_conf_conf_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Analytics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Analytics) ProtoMessage() {}
+
+func (x *Analytics) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Analytics.ProtoReflect.Descriptor instead.
+func (*Analytics) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Analytics) GetStaleThresholds() *StaleThresholds {
+	if x != nil {
+		return x.StaleThresholds
+	}
+	return nil
+}
+
+func (x *Analytics) GetRepositories() []*Repository {
+	if x != nil {
+		return x.Repositories
+	}
+	return nil
+}
+
+func (x *Analytics) GetPrSize() *PRSizeSettings {
+	if x != nil {
+		return x.PrSize
+	}
+	return nil
+}
+
+func (x *Analytics) GetBotFilter() *BotFilter {
+	if x != nil {
+		return x.BotFilter
+	}
+	return nil
+}
+
+func (x *Analytics) GetWorkingHours() *WorkingHours {
+	if x != nil {
+		return x.WorkingHours
+	}
+	return nil
+}
+
+func (x *Analytics) GetHygiene() *HygieneRules {
+	if x != nil {
+		return x.Hygiene
+	}
+	return nil
+}
+
+func (x *Analytics) GetGoals() []*Goal {
+	if x != nil {
+		return x.Goals
+	}
+	return nil
+}
+
+type Repository struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Owner           string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Repo            string                 `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
+	StaleThresholds *StaleThresholds       `protobuf:"bytes,3,opt,name=stale_thresholds,json=staleThresholds,proto3" json:"stale_thresholds,omitempty"`
+	PrSize          *PRSizeSettings        `protobuf:"bytes,4,opt,name=pr_size,json=prSize,proto3" json:"pr_size,omitempty"`
+	Hygiene         *HygieneRules          `protobuf:"bytes,5,opt,name=hygiene,proto3" json:"hygiene,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Repository) Reset() {
+	*x = Repository{}
+	mi := &file_conf_conf_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Repository) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Repository) ProtoMessage() {}
+
+func (x *Repository) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Repository.ProtoReflect.Descriptor instead.
+func (*Repository) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Repository) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *Repository) GetRepo() string {
+	if x != nil {
+		return x.Repo
+	}
+	return ""
+}
+
+func (x *Repository) GetStaleThresholds() *StaleThresholds {
+	if x != nil {
+		return x.StaleThresholds
+	}
+	return nil
+}
+
+func (x *Repository) GetPrSize() *PRSizeSettings {
+	if x != nil {
+		return x.PrSize
+	}
+	return nil
+}
+
+func (x *Repository) GetHygiene() *HygieneRules {
+	if x != nil {
+		return x.Hygiene
+	}
+	return nil
+}
+
+type StaleThresholds struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	InactiveDays   int32                  `protobuf:"varint,1,opt,name=inactive_days,json=inactiveDays,proto3" json:"inactive_days,omitempty"`
+	ReviewSlaHours int32                  `protobuf:"varint,2,opt,name=review_sla_hours,json=reviewSlaHours,proto3" json:"review_sla_hours,omitempty"`
+	LargeDiffLines int32                  `protobuf:"varint,3,opt,name=large_diff_lines,json=largeDiffLines,proto3" json:"large_diff_lines,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StaleThresholds) Reset() {
+	*x = StaleThresholds{}
+	mi := &file_conf_conf_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StaleThresholds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StaleThresholds) ProtoMessage() {}
+
+func (x *StaleThresholds) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StaleThresholds.ProtoReflect.Descriptor instead.
+func (*StaleThresholds) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *StaleThresholds) GetInactiveDays() int32 {
+	if x != nil {
+		return x.InactiveDays
+	}
+	return 0
+}
+
+func (x *StaleThresholds) GetReviewSlaHours() int32 {
+	if x != nil {
+		return x.ReviewSlaHours
+	}
+	return 0
+}
+
+func (x *StaleThresholds) GetLargeDiffLines() int32 {
+	if x != nil {
+		return x.LargeDiffLines
+	}
+	return 0
+}
+
+type PRSizeSettings struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SmallMaxLines  int32                  `protobuf:"varint,1,opt,name=small_max_lines,json=smallMaxLines,proto3" json:"small_max_lines,omitempty"`
+	MediumMaxLines int32                  `protobuf:"varint,2,opt,name=medium_max_lines,json=mediumMaxLines,proto3" json:"medium_max_lines,omitempty"`
+	ExcludeGlobs   []string               `protobuf:"bytes,3,rep,name=exclude_globs,json=excludeGlobs,proto3" json:"exclude_globs,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PRSizeSettings) Reset() {
+	*x = PRSizeSettings{}
+	mi := &file_conf_conf_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PRSizeSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PRSizeSettings) ProtoMessage() {}
+
+func (x *PRSizeSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PRSizeSettings.ProtoReflect.Descriptor instead.
+func (*PRSizeSettings) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PRSizeSettings) GetSmallMaxLines() int32 {
+	if x != nil {
+		return x.SmallMaxLines
+	}
+	return 0
+}
+
+func (x *PRSizeSettings) GetMediumMaxLines() int32 {
+	if x != nil {
+		return x.MediumMaxLines
+	}
+	return 0
+}
+
+func (x *PRSizeSettings) GetExcludeGlobs() []string {
+	if x != nil {
+		return x.ExcludeGlobs
+	}
+	return nil
+}
+
+type BotFilter struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	IgnoreUserType bool                   `protobuf:"varint,1,opt,name=ignore_user_type,json=ignoreUserType,proto3" json:"ignore_user_type,omitempty"`
+	LoginPatterns  []string               `protobuf:"bytes,2,rep,name=login_patterns,json=loginPatterns,proto3" json:"login_patterns,omitempty"`
+	Logins         []string               `protobuf:"bytes,3,rep,name=logins,proto3" json:"logins,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BotFilter) Reset() {
+	*x = BotFilter{}
+	mi := &file_conf_conf_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BotFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BotFilter) ProtoMessage() {}
+
+func (x *BotFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BotFilter.ProtoReflect.Descriptor instead.
+func (*BotFilter) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *BotFilter) GetIgnoreUserType() bool {
+	if x != nil {
+		return x.IgnoreUserType
+	}
+	return false
+}
+
+func (x *BotFilter) GetLoginPatterns() []string {
+	if x != nil {
+		return x.LoginPatterns
+	}
+	return nil
+}
+
+func (x *BotFilter) GetLogins() []string {
+	if x != nil {
+		return x.Logins
+	}
+	return nil
+}
+
+type WorkingHours struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timezone      string                 `protobuf:"bytes,1,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	StartHour     *int32                 `protobuf:"varint,2,opt,name=start_hour,json=startHour,proto3,oneof" json:"start_hour,omitempty"`
+	EndHour       *int32                 `protobuf:"varint,3,opt,name=end_hour,json=endHour,proto3,oneof" json:"end_hour,omitempty"`
+	WorkingDays   []string               `protobuf:"bytes,4,rep,name=working_days,json=workingDays,proto3" json:"working_days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkingHours) Reset() {
+	*x = WorkingHours{}
+	mi := &file_conf_conf_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkingHours) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkingHours) ProtoMessage() {}
+
+func (x *WorkingHours) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkingHours.ProtoReflect.Descriptor instead.
+func (*WorkingHours) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *WorkingHours) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *WorkingHours) GetStartHour() int32 {
+	if x != nil && x.StartHour != nil {
+		return *x.StartHour
+	}
+	return 0
+}
+
+func (x *WorkingHours) GetEndHour() int32 {
+	if x != nil && x.EndHour != nil {
+		return *x.EndHour
+	}
+	return 0
+}
+
+func (x *WorkingHours) GetWorkingDays() []string {
+	if x != nil {
+		return x.WorkingDays
+	}
+	return nil
+}
+
+type HygieneRules struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Rules             []string               `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	ConventionalTypes []string               `protobuf:"bytes,2,rep,name=conventional_types,json=conventionalTypes,proto3" json:"conventional_types,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *HygieneRules) Reset() {
+	*x = HygieneRules{}
+	mi := &file_conf_conf_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HygieneRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HygieneRules) ProtoMessage() {}
+
+func (x *HygieneRules) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HygieneRules.ProtoReflect.Descriptor instead.
+func (*HygieneRules) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *HygieneRules) GetRules() []string {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *HygieneRules) GetConventionalTypes() []string {
+	if x != nil {
+		return x.ConventionalTypes
+	}
+	return nil
+}
+
+type Goal struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Owner         string                 `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Repo          string                 `protobuf:"bytes,3,opt,name=repo,proto3" json:"repo,omitempty"`
+	Team          string                 `protobuf:"bytes,4,opt,name=team,proto3" json:"team,omitempty"`
+	Metric        string                 `protobuf:"bytes,5,opt,name=metric,proto3" json:"metric,omitempty"`
+	Comparator    string                 `protobuf:"bytes,6,opt,name=comparator,proto3" json:"comparator,omitempty"`
+	Target        float64                `protobuf:"fixed64,7,opt,name=target,proto3" json:"target,omitempty"`
+	WarnMargin    float64                `protobuf:"fixed64,8,opt,name=warn_margin,json=warnMargin,proto3" json:"warn_margin,omitempty"`
+	WindowDays    int32                  `protobuf:"varint,9,opt,name=window_days,json=windowDays,proto3" json:"window_days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Goal) Reset() {
+	*x = Goal{}
+	mi := &file_conf_conf_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Goal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Goal) ProtoMessage() {}
+
+func (x *Goal) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Goal.ProtoReflect.Descriptor instead.
+func (*Goal) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Goal) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Goal) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *Goal) GetRepo() string {
+	if x != nil {
+		return x.Repo
+	}
+	return ""
+}
+
+func (x *Goal) GetTeam() string {
+	if x != nil {
+		return x.Team
+	}
+	return ""
+}
+
+func (x *Goal) GetMetric() string {
+	if x != nil {
+		return x.Metric
+	}
+	return ""
+}
+
+func (x *Goal) GetComparator() string {
+	if x != nil {
+		return x.Comparator
+	}
+	return ""
+}
+
+func (x *Goal) GetTarget() float64 {
+	if x != nil {
+		return x.Target
+	}
+	return 0
+}
+
+func (x *Goal) GetWarnMargin() float64 {
+	if x != nil {
+		return x.WarnMargin
+	}
+	return 0
+}
+
+func (x *Goal) GetWindowDays() int32 {
+	if x != nil {
+		return x.WindowDays
+	}
+	return 0
+}
+
+type Alerting struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	IntervalSeconds       int64                  `protobuf:"varint,1,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
+	RepeatIntervalSeconds int64                  `protobuf:"varint,2,opt,name=repeat_interval_seconds,json=repeatIntervalSeconds,proto3" json:"repeat_interval_seconds,omitempty"`
+	Rules                 []*AlertRule           `protobuf:"bytes,3,rep,name=rules,proto3" json:"rules,omitempty"`
+	Sinks                 []*NotificationSink    `protobuf:"bytes,4,rep,name=sinks,proto3" json:"sinks,omitempty"`
+	Silences              []*Silence             `protobuf:"bytes,5,rep,name=silences,proto3" json:"silences,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *Alerting) Reset() {
+	*x = Alerting{}
+	mi := &file_conf_conf_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Alerting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Alerting) ProtoMessage() {}
+
+func (x *Alerting) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Alerting.ProtoReflect.Descriptor instead.
+func (*Alerting) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Alerting) GetIntervalSeconds() int64 {
+	if x != nil {
+		return x.IntervalSeconds
+	}
+	return 0
+}
+
+func (x *Alerting) GetRepeatIntervalSeconds() int64 {
+	if x != nil {
+		return x.RepeatIntervalSeconds
+	}
+	return 0
+}
+
+func (x *Alerting) GetRules() []*AlertRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *Alerting) GetSinks() []*NotificationSink {
+	if x != nil {
+		return x.Sinks
+	}
+	return nil
+}
+
+func (x *Alerting) GetSilences() []*Silence {
+	if x != nil {
+		return x.Silences
+	}
+	return nil
+}
+
+type AlertRule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Owner         string                 `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	Repo          string                 `protobuf:"bytes,4,opt,name=repo,proto3" json:"repo,omitempty"`
+	Team          string                 `protobuf:"bytes,5,opt,name=team,proto3" json:"team,omitempty"`
+	Metric        string                 `protobuf:"bytes,6,opt,name=metric,proto3" json:"metric,omitempty"`
+	Comparator    string                 `protobuf:"bytes,7,opt,name=comparator,proto3" json:"comparator,omitempty"`
+	Threshold     float64                `protobuf:"fixed64,8,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	WindowDays    int32                  `protobuf:"varint,9,opt,name=window_days,json=windowDays,proto3" json:"window_days,omitempty"`
+	Severity      string                 `protobuf:"bytes,10,opt,name=severity,proto3" json:"severity,omitempty"`
+	Sinks         []string               `protobuf:"bytes,11,rep,name=sinks,proto3" json:"sinks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AlertRule) Reset() {
+	*x = AlertRule{}
+	mi := &file_conf_conf_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlertRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlertRule) ProtoMessage() {}
+
+func (x *AlertRule) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlertRule.ProtoReflect.Descriptor instead.
+func (*AlertRule) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AlertRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AlertRule) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *AlertRule) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *AlertRule) GetRepo() string {
+	if x != nil {
+		return x.Repo
+	}
+	return ""
+}
+
+func (x *AlertRule) GetTeam() string {
+	if x != nil {
+		return x.Team
+	}
+	return ""
+}
+
+func (x *AlertRule) GetMetric() string {
+	if x != nil {
+		return x.Metric
+	}
+	return ""
+}
+
+func (x *AlertRule) GetComparator() string {
+	if x != nil {
+		return x.Comparator
+	}
+	return ""
+}
+
+func (x *AlertRule) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *AlertRule) GetWindowDays() int32 {
+	if x != nil {
+		return x.WindowDays
+	}
+	return 0
+}
+
+func (x *AlertRule) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *AlertRule) GetSinks() []string {
+	if x != nil {
+		return x.Sinks
+	}
+	return nil
+}
+
+type NotificationSink struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Name               string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type               string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	SmtpAddr           string                 `protobuf:"bytes,4,opt,name=smtp_addr,json=smtpAddr,proto3" json:"smtp_addr,omitempty"`
+	From               string                 `protobuf:"bytes,5,opt,name=from,proto3" json:"from,omitempty"`
+	To                 []string               `protobuf:"bytes,6,rep,name=to,proto3" json:"to,omitempty"`
+	SecretFileLocation string                 `protobuf:"bytes,7,opt,name=secret_file_location,json=secretFileLocation,proto3" json:"secret_file_location,omitempty"`
+	UrlEnv             string                 `protobuf:"bytes,8,opt,name=url_env,json=urlEnv,proto3" json:"url_env,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *NotificationSink) Reset() {
+	*x = NotificationSink{}
+	mi := &file_conf_conf_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationSink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationSink) ProtoMessage() {}
+
+func (x *NotificationSink) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationSink.ProtoReflect.Descriptor instead.
+func (*NotificationSink) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *NotificationSink) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NotificationSink) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *NotificationSink) GetSmtpAddr() string {
+	if x != nil {
+		return x.SmtpAddr
+	}
+	return ""
+}
+
+func (x *NotificationSink) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *NotificationSink) GetTo() []string {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *NotificationSink) GetSecretFileLocation() string {
+	if x != nil {
+		return x.SecretFileLocation
+	}
+	return ""
+}
+
+func (x *NotificationSink) GetUrlEnv() string {
+	if x != nil {
+		return x.UrlEnv
+	}
+	return ""
+}
+
+type Silence struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []string               `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	StartsAt      string                 `protobuf:"bytes,2,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt        string                 `protobuf:"bytes,3,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Logger) Reset() {
-	*x = Logger{}
-	mi := &file_conf_conf_proto_msgTypes[1]
+func (x *Silence) Reset() {
+	*x = Silence{}
+	mi := &file_conf_conf_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Logger) String() string {
+func (x *Silence) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Logger) ProtoMessage() {}
+func (*Silence) ProtoMessage() {}
 
-func (x *Logger) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[1]
+func (x *Silence) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -105,42 +1112,69 @@ func (x *Logger) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Logger.ProtoReflect.Descriptor instead.
-func (*Logger) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use Silence.ProtoReflect.Descriptor instead.
+func (*Silence) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *Logger) GetLevel() string {
+func (x *Silence) GetRules() []string {
 	if x != nil {
-		return x.Level
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *Silence) GetStartsAt() string {
+	if x != nil {
+		return x.StartsAt
 	}
 	return ""
 }
 
-type Server struct {
-	state                    protoimpl.MessageState `protogen:"open.v1"`
-	Http                     *Server_HTTP           `protobuf:"bytes,1,opt,name=http,proto3" json:"http,omitempty"`
-	Grpc                     *Server_GRPC           `protobuf:"bytes,2,opt,name=grpc,proto3" json:"grpc,omitempty"`
-	GithubSecretFileLocation string                 `protobuf:"bytes,3,opt,name=github_secret_file_location,json=githubSecretFileLocation,proto3" json:"github_secret_file_location,omitempty"`
-	unknownFields            protoimpl.UnknownFields
-	sizeCache                protoimpl.SizeCache
+func (x *Silence) GetEndsAt() string {
+	if x != nil {
+		return x.EndsAt
+	}
+	return ""
 }
 
-func (x *Server) Reset() {
-	*x = Server{}
-	mi := &file_conf_conf_proto_msgTypes[2]
+func (x *Silence) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type Digests struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Weekday          string                 `protobuf:"bytes,1,opt,name=weekday,proto3" json:"weekday,omitempty"`
+	Hour             *int32                 `protobuf:"varint,2,opt,name=hour,proto3,oneof" json:"hour,omitempty"`
+	Timezone         string                 `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	History          int32                  `protobuf:"varint,4,opt,name=history,proto3" json:"history,omitempty"`
+	MarkdownTemplate string                 `protobuf:"bytes,5,opt,name=markdown_template,json=markdownTemplate,proto3" json:"markdown_template,omitempty"`
+	HtmlTemplate     string                 `protobuf:"bytes,6,opt,name=html_template,json=htmlTemplate,proto3" json:"html_template,omitempty"`
+	Targets          []*DigestTarget        `protobuf:"bytes,7,rep,name=targets,proto3" json:"targets,omitempty"`
+	Sinks            []*NotificationSink    `protobuf:"bytes,8,rep,name=sinks,proto3" json:"sinks,omitempty"`
+	StorePath        string                 `protobuf:"bytes,9,opt,name=store_path,json=storePath,proto3" json:"store_path,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Digests) Reset() {
+	*x = Digests{}
+	mi := &file_conf_conf_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Server) String() string {
+func (x *Digests) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Server) ProtoMessage() {}
+func (*Digests) ProtoMessage() {}
 
-func (x *Server) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[2]
+func (x *Digests) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -151,32 +1185,218 @@ func (x *Server) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Server.ProtoReflect.Descriptor instead.
-func (*Server) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use Digests.ProtoReflect.Descriptor instead.
+func (*Digests) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *Server) GetHttp() *Server_HTTP {
+func (x *Digests) GetWeekday() string {
 	if x != nil {
-		return x.Http
+		return x.Weekday
+	}
+	return ""
+}
+
+func (x *Digests) GetHour() int32 {
+	if x != nil && x.Hour != nil {
+		return *x.Hour
+	}
+	return 0
+}
+
+func (x *Digests) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *Digests) GetHistory() int32 {
+	if x != nil {
+		return x.History
+	}
+	return 0
+}
+
+func (x *Digests) GetMarkdownTemplate() string {
+	if x != nil {
+		return x.MarkdownTemplate
+	}
+	return ""
+}
+
+func (x *Digests) GetHtmlTemplate() string {
+	if x != nil {
+		return x.HtmlTemplate
+	}
+	return ""
+}
+
+func (x *Digests) GetTargets() []*DigestTarget {
+	if x != nil {
+		return x.Targets
 	}
 	return nil
 }
 
-func (x *Server) GetGrpc() *Server_GRPC {
+func (x *Digests) GetSinks() []*NotificationSink {
 	if x != nil {
-		return x.Grpc
+		return x.Sinks
 	}
 	return nil
 }
 
-func (x *Server) GetGithubSecretFileLocation() string {
+func (x *Digests) GetStorePath() string {
 	if x != nil {
-		return x.GithubSecretFileLocation
+		return x.StorePath
+	}
+	return ""
+}
+
+type DigestTarget struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Owner         string                 `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Repo          string                 `protobuf:"bytes,3,opt,name=repo,proto3" json:"repo,omitempty"`
+	Team          string                 `protobuf:"bytes,4,opt,name=team,proto3" json:"team,omitempty"`
+	Sinks         []string               `protobuf:"bytes,5,rep,name=sinks,proto3" json:"sinks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DigestTarget) Reset() {
+	*x = DigestTarget{}
+	mi := &file_conf_conf_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DigestTarget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DigestTarget) ProtoMessage() {}
+
+func (x *DigestTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DigestTarget.ProtoReflect.Descriptor instead.
+func (*DigestTarget) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DigestTarget) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DigestTarget) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *DigestTarget) GetRepo() string {
+	if x != nil {
+		return x.Repo
+	}
+	return ""
+}
+
+func (x *DigestTarget) GetTeam() string {
+	if x != nil {
+		return x.Team
+	}
+	return ""
+}
+
+func (x *DigestTarget) GetSinks() []string {
+	if x != nil {
+		return x.Sinks
+	}
+	return nil
+}
+
+type Metrics struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Path                string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	SyncIntervalSeconds int64                  `protobuf:"varint,2,opt,name=sync_interval_seconds,json=syncIntervalSeconds,proto3" json:"sync_interval_seconds,omitempty"`
+	WindowDays          int32                  `protobuf:"varint,3,opt,name=window_days,json=windowDays,proto3" json:"window_days,omitempty"`
+	Repositories        []string               `protobuf:"bytes,4,rep,name=repositories,proto3" json:"repositories,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Metrics) Reset() {
+	*x = Metrics{}
+	mi := &file_conf_conf_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Metrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Metrics) ProtoMessage() {}
+
+func (x *Metrics) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Metrics.ProtoReflect.Descriptor instead.
+func (*Metrics) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Metrics) GetPath() string {
+	if x != nil {
+		return x.Path
 	}
 	return ""
 }
 
+func (x *Metrics) GetSyncIntervalSeconds() int64 {
+	if x != nil {
+		return x.SyncIntervalSeconds
+	}
+	return 0
+}
+
+func (x *Metrics) GetWindowDays() int32 {
+	if x != nil {
+		return x.WindowDays
+	}
+	return 0
+}
+
+func (x *Metrics) GetRepositories() []string {
+	if x != nil {
+		return x.Repositories
+	}
+	return nil
+}
+
 type Server_HTTP struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
@@ -188,7 +1408,7 @@ type Server_HTTP struct {
 
 func (x *Server_HTTP) Reset() {
 	*x = Server_HTTP{}
-	mi := &file_conf_conf_proto_msgTypes[3]
+	mi := &file_conf_conf_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -200,7 +1420,7 @@ func (x *Server_HTTP) String() string {
 func (*Server_HTTP) ProtoMessage() {}
 
 func (x *Server_HTTP) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[3]
+	mi := &file_conf_conf_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -248,7 +1468,7 @@ type Server_GRPC struct {
 
 func (x *Server_GRPC) Reset() {
 	*x = Server_GRPC{}
-	mi := &file_conf_conf_proto_msgTypes[4]
+	mi := &file_conf_conf_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -260,7 +1480,7 @@ func (x *Server_GRPC) String() string {
 func (*Server_GRPC) ProtoMessage() {}
 
 func (x *Server_GRPC) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[4]
+	mi := &file_conf_conf_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -302,10 +1522,15 @@ var File_conf_conf_proto protoreflect.FileDescriptor
 const file_conf_conf_proto_rawDesc = "" +
 	"\n" +
 	"\x0fconf/conf.proto\x12\n" +
-	"kratos.api\"c\n" +
+	"kratos.api\"\xa8\x02\n" +
 	"\tBootstrap\x12*\n" +
 	"\x06server\x18\x01 \x01(\v2\x12.kratos.api.ServerR\x06server\x12*\n" +
-	"\x06logger\x18\x06 \x01(\v2\x12.kratos.api.LoggerR\x06logger\"\x1e\n" +
+	"\x06logger\x18\x06 \x01(\v2\x12.kratos.api.LoggerR\x06logger\x123\n" +
+	"\tanalytics\x18\a \x01(\v2\x15.kratos.api.AnalyticsR\tanalytics\x120\n" +
+	"\balerting\x18\b \x01(\v2\x14.kratos.api.AlertingR\balerting\x12-\n" +
+	"\adigests\x18\t \x01(\v2\x13.kratos.api.DigestsR\adigests\x12-\n" +
+	"\ametrics\x18\n" +
+	" \x01(\v2\x13.kratos.api.MetricsR\ametrics\"\x1e\n" +
 	"\x06Logger\x12\x14\n" +
 	"\x05level\x18\x01 \x01(\tR\x05level\"\xc1\x02\n" +
 	"\x06Server\x12+\n" +
@@ -319,7 +1544,119 @@ const file_conf_conf_proto_rawDesc = "" +
 	"\x04GRPC\x12\x18\n" +
 	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
 	"\x04addr\x18\x02 \x01(\tR\x04addr\x12\x18\n" +
-	"\atimeout\x18\x03 \x01(\x03R\atimeoutB.Z,entity-insights-dashboard/internal/conf;confb\x06proto3"
+	"\atimeout\x18\x03 \x01(\x03R\atimeout\"\x95\x03\n" +
+	"\tAnalytics\x12F\n" +
+	"\x10stale_thresholds\x18\x01 \x01(\v2\x1b.kratos.api.StaleThresholdsR\x0fstaleThresholds\x12:\n" +
+	"\frepositories\x18\x02 \x03(\v2\x16.kratos.api.RepositoryR\frepositories\x123\n" +
+	"\apr_size\x18\x03 \x01(\v2\x1a.kratos.api.PRSizeSettingsR\x06prSize\x124\n" +
+	"\n" +
+	"bot_filter\x18\x04 \x01(\v2\x15.kratos.api.BotFilterR\tbotFilter\x12=\n" +
+	"\rworking_hours\x18\x05 \x01(\v2\x18.kratos.api.WorkingHoursR\fworkingHours\x122\n" +
+	"\ahygiene\x18\x06 \x01(\v2\x18.kratos.api.HygieneRulesR\ahygiene\x12&\n" +
+	"\x05goals\x18\a \x03(\v2\x10.kratos.api.GoalR\x05goals\"\xe7\x01\n" +
+	"\n" +
+	"Repository\x12\x14\n" +
+	"\x05owner\x18\x01 \x01(\tR\x05owner\x12\x12\n" +
+	"\x04repo\x18\x02 \x01(\tR\x04repo\x12F\n" +
+	"\x10stale_thresholds\x18\x03 \x01(\v2\x1b.kratos.api.StaleThresholdsR\x0fstaleThresholds\x123\n" +
+	"\apr_size\x18\x04 \x01(\v2\x1a.kratos.api.PRSizeSettingsR\x06prSize\x122\n" +
+	"\ahygiene\x18\x05 \x01(\v2\x18.kratos.api.HygieneRulesR\ahygiene\"\x8a\x01\n" +
+	"\x0fStaleThresholds\x12#\n" +
+	"\rinactive_days\x18\x01 \x01(\x05R\finactiveDays\x12(\n" +
+	"\x10review_sla_hours\x18\x02 \x01(\x05R\x0ereviewSlaHours\x12(\n" +
+	"\x10large_diff_lines\x18\x03 \x01(\x05R\x0elargeDiffLines\"\x87\x01\n" +
+	"\x0ePRSizeSettings\x12&\n" +
+	"\x0fsmall_max_lines\x18\x01 \x01(\x05R\rsmallMaxLines\x12(\n" +
+	"\x10medium_max_lines\x18\x02 \x01(\x05R\x0emediumMaxLines\x12#\n" +
+	"\rexclude_globs\x18\x03 \x03(\tR\fexcludeGlobs\"t\n" +
+	"\tBotFilter\x12(\n" +
+	"\x10ignore_user_type\x18\x01 \x01(\bR\x0eignoreUserType\x12%\n" +
+	"\x0elogin_patterns\x18\x02 \x03(\tR\rloginPatterns\x12\x16\n" +
+	"\x06logins\x18\x03 \x03(\tR\x06logins\"\xad\x01\n" +
+	"\fWorkingHours\x12\x1a\n" +
+	"\btimezone\x18\x01 \x01(\tR\btimezone\x12\"\n" +
+	"\n" +
+	"start_hour\x18\x02 \x01(\x05H\x00R\tstartHour\x88\x01\x01\x12\x1e\n" +
+	"\bend_hour\x18\x03 \x01(\x05H\x01R\aendHour\x88\x01\x01\x12!\n" +
+	"\fworking_days\x18\x04 \x03(\tR\vworkingDaysB\r\n" +
+	"\v_start_hourB\v\n" +
+	"\t_end_hour\"S\n" +
+	"\fHygieneRules\x12\x14\n" +
+	"\x05rules\x18\x01 \x03(\tR\x05rules\x12-\n" +
+	"\x12conventional_types\x18\x02 \x03(\tR\x11conventionalTypes\"\xea\x01\n" +
+	"\x04Goal\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05owner\x18\x02 \x01(\tR\x05owner\x12\x12\n" +
+	"\x04repo\x18\x03 \x01(\tR\x04repo\x12\x12\n" +
+	"\x04team\x18\x04 \x01(\tR\x04team\x12\x16\n" +
+	"\x06metric\x18\x05 \x01(\tR\x06metric\x12\x1e\n" +
+	"\n" +
+	"comparator\x18\x06 \x01(\tR\n" +
+	"comparator\x12\x16\n" +
+	"\x06target\x18\a \x01(\x01R\x06target\x12\x1f\n" +
+	"\vwarn_margin\x18\b \x01(\x01R\n" +
+	"warnMargin\x12\x1f\n" +
+	"\vwindow_days\x18\t \x01(\x05R\n" +
+	"windowDays\"\xff\x01\n" +
+	"\bAlerting\x12)\n" +
+	"\x10interval_seconds\x18\x01 \x01(\x03R\x0fintervalSeconds\x126\n" +
+	"\x17repeat_interval_seconds\x18\x02 \x01(\x03R\x15repeatIntervalSeconds\x12+\n" +
+	"\x05rules\x18\x03 \x03(\v2\x15.kratos.api.AlertRuleR\x05rules\x122\n" +
+	"\x05sinks\x18\x04 \x03(\v2\x1c.kratos.api.NotificationSinkR\x05sinks\x12/\n" +
+	"\bsilences\x18\x05 \x03(\v2\x13.kratos.api.SilenceR\bsilences\"\xa8\x02\n" +
+	"\tAlertRule\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x14\n" +
+	"\x05owner\x18\x03 \x01(\tR\x05owner\x12\x12\n" +
+	"\x04repo\x18\x04 \x01(\tR\x04repo\x12\x12\n" +
+	"\x04team\x18\x05 \x01(\tR\x04team\x12\x16\n" +
+	"\x06metric\x18\x06 \x01(\tR\x06metric\x12\x1e\n" +
+	"\n" +
+	"comparator\x18\a \x01(\tR\n" +
+	"comparator\x12\x1c\n" +
+	"\tthreshold\x18\b \x01(\x01R\tthreshold\x12\x1f\n" +
+	"\vwindow_days\x18\t \x01(\x05R\n" +
+	"windowDays\x12\x1a\n" +
+	"\bseverity\x18\n" +
+	" \x01(\tR\bseverity\x12\x14\n" +
+	"\x05sinks\x18\v \x03(\tR\x05sinks\"\xcc\x01\n" +
+	"\x10NotificationSink\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x1b\n" +
+	"\tsmtp_addr\x18\x04 \x01(\tR\bsmtpAddr\x12\x12\n" +
+	"\x04from\x18\x05 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x06 \x03(\tR\x02to\x120\n" +
+	"\x14secret_file_location\x18\a \x01(\tR\x12secretFileLocation\x12\x17\n" +
+	"\aurl_env\x18\b \x01(\tR\x06urlEnvJ\x04\b\x03\x10\x04\"m\n" +
+	"\aSilence\x12\x14\n" +
+	"\x05rules\x18\x01 \x03(\tR\x05rules\x12\x1b\n" +
+	"\tstarts_at\x18\x02 \x01(\tR\bstartsAt\x12\x17\n" +
+	"\aends_at\x18\x03 \x01(\tR\x06endsAt\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"\xd4\x02\n" +
+	"\aDigests\x12\x18\n" +
+	"\aweekday\x18\x01 \x01(\tR\aweekday\x12\x17\n" +
+	"\x04hour\x18\x02 \x01(\x05H\x00R\x04hour\x88\x01\x01\x12\x1a\n" +
+	"\btimezone\x18\x03 \x01(\tR\btimezone\x12\x18\n" +
+	"\ahistory\x18\x04 \x01(\x05R\ahistory\x12+\n" +
+	"\x11markdown_template\x18\x05 \x01(\tR\x10markdownTemplate\x12#\n" +
+	"\rhtml_template\x18\x06 \x01(\tR\fhtmlTemplate\x122\n" +
+	"\atargets\x18\a \x03(\v2\x18.kratos.api.DigestTargetR\atargets\x122\n" +
+	"\x05sinks\x18\b \x03(\v2\x1c.kratos.api.NotificationSinkR\x05sinks\x12\x1d\n" +
+	"\n" +
+	"store_path\x18\t \x01(\tR\tstorePathB\a\n" +
+	"\x05_hour\"v\n" +
+	"\fDigestTarget\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05owner\x18\x02 \x01(\tR\x05owner\x12\x12\n" +
+	"\x04repo\x18\x03 \x01(\tR\x04repo\x12\x12\n" +
+	"\x04team\x18\x04 \x01(\tR\x04team\x12\x14\n" +
+	"\x05sinks\x18\x05 \x03(\tR\x05sinks\"\x96\x01\n" +
+	"\aMetrics\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x122\n" +
+	"\x15sync_interval_seconds\x18\x02 \x01(\x03R\x13syncIntervalSeconds\x12\x1f\n" +
+	"\vwindow_days\x18\x03 \x01(\x05R\n" +
+	"windowDays\x12\"\n" +
+	"\frepositories\x18\x04 \x03(\tR\frepositoriesB.Z,entity-insights-dashboard/internal/conf;confb\x06proto3"
 
 var (
 	file_conf_conf_proto_rawDescOnce sync.Once
@@ -333,24 +1670,58 @@ func file_conf_conf_proto_rawDescGZIP() []byte {
 	return file_conf_conf_proto_rawDescData
 }
 
-var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_conf_conf_proto_goTypes = []any{
-	(*Bootstrap)(nil),   // 0: kratos.api.Bootstrap
-	(*Logger)(nil),      // 1: kratos.api.Logger
-	(*Server)(nil),      // 2: kratos.api.Server
-	(*Server_HTTP)(nil), // 3: kratos.api.Server.HTTP
-	(*Server_GRPC)(nil), // 4: kratos.api.Server.GRPC
+	(*Bootstrap)(nil),        // 0: kratos.api.Bootstrap
+	(*Logger)(nil),           // 1: kratos.api.Logger
+	(*Server)(nil),           // 2: kratos.api.Server
+	(*Analytics)(nil),        // 3: kratos.api.Analytics
+	(*Repository)(nil),       // 4: kratos.api.Repository
+	(*StaleThresholds)(nil),  // 5: kratos.api.StaleThresholds
+	(*PRSizeSettings)(nil),   // 6: kratos.api.PRSizeSettings
+	(*BotFilter)(nil),        // 7: kratos.api.BotFilter
+	(*WorkingHours)(nil),     // 8: kratos.api.WorkingHours
+	(*HygieneRules)(nil),     // 9: kratos.api.HygieneRules
+	(*Goal)(nil),             // 10: kratos.api.Goal
+	(*Alerting)(nil),         // 11: kratos.api.Alerting
+	(*AlertRule)(nil),        // 12: kratos.api.AlertRule
+	(*NotificationSink)(nil), // 13: kratos.api.NotificationSink
+	(*Silence)(nil),          // 14: kratos.api.Silence
+	(*Digests)(nil),          // 15: kratos.api.Digests
+	(*DigestTarget)(nil),     // 16: kratos.api.DigestTarget
+	(*Metrics)(nil),          // 17: kratos.api.Metrics
+	(*Server_HTTP)(nil),      // 18: kratos.api.Server.HTTP
+	(*Server_GRPC)(nil),      // 19: kratos.api.Server.GRPC
 }
 var file_conf_conf_proto_depIdxs = []int32{
-	2, // 0: kratos.api.Bootstrap.server:type_name -> kratos.api.Server
-	1, // 1: kratos.api.Bootstrap.logger:type_name -> kratos.api.Logger
-	3, // 2: kratos.api.Server.http:type_name -> kratos.api.Server.HTTP
-	4, // 3: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	2,  // 0: kratos.api.Bootstrap.server:type_name -> kratos.api.Server
+	1,  // 1: kratos.api.Bootstrap.logger:type_name -> kratos.api.Logger
+	3,  // 2: kratos.api.Bootstrap.analytics:type_name -> kratos.api.Analytics
+	11, // 3: kratos.api.Bootstrap.alerting:type_name -> kratos.api.Alerting
+	15, // 4: kratos.api.Bootstrap.digests:type_name -> kratos.api.Digests
+	17, // 5: kratos.api.Bootstrap.metrics:type_name -> kratos.api.Metrics
+	18, // 6: kratos.api.Server.http:type_name -> kratos.api.Server.HTTP
+	19, // 7: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
+	5,  // 8: kratos.api.Analytics.stale_thresholds:type_name -> kratos.api.StaleThresholds
+	4,  // 9: kratos.api.Analytics.repositories:type_name -> kratos.api.Repository
+	6,  // 10: kratos.api.Analytics.pr_size:type_name -> kratos.api.PRSizeSettings
+	7,  // 11: kratos.api.Analytics.bot_filter:type_name -> kratos.api.BotFilter
+	8,  // 12: kratos.api.Analytics.working_hours:type_name -> kratos.api.WorkingHours
+	9,  // 13: kratos.api.Analytics.hygiene:type_name -> kratos.api.HygieneRules
+	10, // 14: kratos.api.Analytics.goals:type_name -> kratos.api.Goal
+	5,  // 15: kratos.api.Repository.stale_thresholds:type_name -> kratos.api.StaleThresholds
+	6,  // 16: kratos.api.Repository.pr_size:type_name -> kratos.api.PRSizeSettings
+	9,  // 17: kratos.api.Repository.hygiene:type_name -> kratos.api.HygieneRules
+	12, // 18: kratos.api.Alerting.rules:type_name -> kratos.api.AlertRule
+	13, // 19: kratos.api.Alerting.sinks:type_name -> kratos.api.NotificationSink
+	14, // 20: kratos.api.Alerting.silences:type_name -> kratos.api.Silence
+	16, // 21: kratos.api.Digests.targets:type_name -> kratos.api.DigestTarget
+	13, // 22: kratos.api.Digests.sinks:type_name -> kratos.api.NotificationSink
+	23, // [23:23] is the sub-list for method output_type
+	23, // [23:23] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_conf_conf_proto_init() }
@@ -358,13 +1729,15 @@ func file_conf_conf_proto_init() {
 	if File_conf_conf_proto != nil {
 		return
 	}
+	file_conf_conf_proto_msgTypes[8].OneofWrappers = []any{}
+	file_conf_conf_proto_msgTypes[15].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_conf_conf_proto_rawDesc), len(file_conf_conf_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
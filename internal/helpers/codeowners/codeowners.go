@@ -0,0 +1,81 @@
+// Package codeowners parses CODEOWNERS files and resolves the owners of a path.
+package codeowners
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+
+	"luminex-service/internal/helpers/pathmatch"
+)
+
+// Locations lists where GitHub looks for a CODEOWNERS file, in order of precedence.
+var Locations = []string{".github/CODEOWNERS", "CODEOWNERS", "docs/CODEOWNERS"}
+
+type Rule struct {
+	Pattern *pathmatch.Pattern
+	Owners  []string
+	Line    int
+}
+
+type File struct {
+	Rules []*Rule
+}
+
+func Parse(content string) (*File, error) {
+	file := &File{}
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	for line := 1; scanner.Scan(); line++ {
+		text := scanner.Text()
+		if i := strings.Index(text, "#"); i >= 0 {
+			text = text[:i]
+		}
+		fields := strings.Fields(text)
+		if len(fields) == 0 {
+			continue
+		}
+		pattern, err := pathmatch.Compile(fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid pattern %q: %w", line, fields[0], err)
+		}
+		file.Rules = append(file.Rules, &Rule{Pattern: pattern, Owners: fields[1:], Line: line})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return file, nil
+}
+
+// Match returns the rule that applies to path. As in GitHub, the last matching rule wins.
+func (f *File) Match(path string) *Rule {
+	for i := len(f.Rules) - 1; i >= 0; i-- {
+		if f.Rules[i].Pattern.Match(path) {
+			return f.Rules[i]
+		}
+	}
+	return nil
+}
+
+// Owners returns the owners of path; a rule without owners leaves the path unowned.
+func (f *File) Owners(path string) []string {
+	if rule := f.Match(path); rule != nil {
+		return rule.Owners
+	}
+	return nil
+}
+
+// IsTeam reports whether owner refers to a team (@org/team).
+func IsTeam(owner string) bool {
+	return strings.HasPrefix(owner, "@") && strings.Contains(owner, "/")
+}
+
+// IsUser reports whether owner refers to a single user (@login).
+func IsUser(owner string) bool {
+	return strings.HasPrefix(owner, "@") && !strings.Contains(owner, "/")
+}
+
+// TeamSlug splits a team owner into its organization and slug.
+func TeamSlug(owner string) (string, string) {
+	org, slug, _ := strings.Cut(strings.TrimPrefix(owner, "@"), "/")
+	return org, slug
+}
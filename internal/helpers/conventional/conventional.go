@@ -0,0 +1,55 @@
+// Package conventional parses titles and commit messages written in the Conventional Commits style,
+// e.g. "feat(api)!: drop v0 endpoints".
+package conventional
+
+import (
+	"regexp"
+	"strings"
+)
+
+var headerPattern = regexp.MustCompile(`^(\w+)(?:\(([^)]*)\))?(!)?: (.+)$`)
+
+type Header struct {
+	Type        string
+	Scope       string
+	Breaking    bool
+	Description string
+}
+
+// Parse reads the header from the first line of message. The body is scanned for a
+// "BREAKING CHANGE:" footer. It reports false when the first line is not conventional.
+func Parse(message string) (*Header, bool) {
+	firstLine, body, _ := strings.Cut(strings.TrimSpace(message), "\n")
+	match := headerPattern.FindStringSubmatch(strings.TrimSpace(firstLine))
+	if match == nil {
+		return nil, false
+	}
+
+	return &Header{
+		Type:        strings.ToLower(match[1]),
+		Scope:       match[2],
+		Breaking:    match[3] == "!" || strings.Contains(body, "BREAKING CHANGE:") || strings.Contains(body, "BREAKING-CHANGE:"),
+		Description: match[4],
+	}, true
+}
+
+// DefaultTypes are the commit types of the Angular convention used by commitlint.
+var DefaultTypes = []string{"build", "chore", "ci", "docs", "feat", "fix", "perf", "refactor", "revert", "style", "test"}
+
+// Valid reports whether title is a conventional header with one of the allowed types.
+// DefaultTypes apply when types is empty.
+func Valid(title string, types []string) bool {
+	header, ok := Parse(title)
+	if !ok {
+		return false
+	}
+	if len(types) == 0 {
+		types = DefaultTypes
+	}
+	for _, t := range types {
+		if strings.EqualFold(header.Type, t) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,194 @@
+package github
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bikash-789/comm-protos/luminex/v1/request"
+	"github.com/bikash-789/comm-protos/luminex/v1/response"
+	"github.com/google/go-github/v50/github"
+	"luminex-service/internal/conf"
+)
+
+const (
+	activityCommit = iota
+	activityPROpened
+	activityReview
+)
+
+// heatmapWeekdays orders the heatmap rows from Monday to Sunday.
+var heatmapWeekdays = []time.Weekday{
+	time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday, time.Sunday,
+}
+
+type workingHours struct {
+	start, end int
+	days       map[time.Weekday]bool
+}
+
+func newWorkingHours(config *conf.WorkingHours) (*workingHours, error) {
+	hours := &workingHours{
+		start: int(config.GetStartHour()),
+		end:   int(config.GetEndHour()),
+		days:  make(map[time.Weekday]bool),
+	}
+	if hours.start < 0 || hours.start > 23 || hours.end < 0 || hours.end > 24 || hours.start == hours.end {
+		return nil, fmt.Errorf("invalid working hours %d-%d, expected distinct hours from 0 to 24", hours.start, hours.end)
+	}
+	for _, day := range config.GetWorkingDays() {
+		matched := false
+		for _, weekday := range heatmapWeekdays {
+			if strings.EqualFold(day, weekday.String()) || strings.EqualFold(day, weekday.String()[:3]) {
+				hours.days[weekday] = true
+				matched = true
+			}
+		}
+		if !matched {
+			return nil, fmt.Errorf("unknown working day %q", day)
+		}
+	}
+	return hours, nil
+}
+
+// contains reports whether t falls in working hours; an end hour before the start hour spans midnight.
+func (w *workingHours) contains(t time.Time) bool {
+	if !w.days[t.Weekday()] {
+		return false
+	}
+	hour := t.Hour()
+	if w.start <= w.end {
+		return hour >= w.start && hour < w.end
+	}
+	return hour >= w.start || hour < w.end
+}
+
+func heatmapRow(weekday time.Weekday) int {
+	return (int(weekday) + 6) % 7
+}
+
+func (g *GithubClient) GetActivityHeatmap(req *request.ActivityHeatmapRequest, config *conf.WorkingHours, filter *AuthorFilter) (*response.ActivityHeatmapResponse, error) {
+	owner := req.Owner
+	repo := req.Repo
+	since := windowStart(req.Days)
+
+	timezone := req.Timezone
+	if timezone == "" {
+		timezone = config.GetTimezone()
+	}
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone %q: %w", timezone, err)
+	}
+	hours, err := newWorkingHours(config)
+	if err != nil {
+		return nil, err
+	}
+
+	var members map[string]bool
+	switch {
+	case req.Username != "":
+		members = map[string]bool{req.Username: true}
+	case req.TeamSlug != "":
+		team, err := g.resolveTeam(req.Org, req.TeamSlug)
+		if err != nil {
+			return nil, err
+		}
+		members = team.members
+	}
+
+	result := &response.ActivityHeatmapResponse{
+		Timezone:  location.String(),
+		StartHour: int32(hours.start),
+		EndHour:   int32(hours.end),
+	}
+	cells := make([][]*response.HeatmapCell, len(heatmapWeekdays))
+	for row, weekday := range heatmapWeekdays {
+		cells[row] = make([]*response.HeatmapCell, 24)
+		for hour := range cells[row] {
+			cell := &response.HeatmapCell{Weekday: weekday.String(), Hour: int32(hour)}
+			cells[row][hour] = cell
+			result.Cells = append(result.Cells, cell)
+		}
+	}
+	contributors := make(map[string]*response.ContributorActivity)
+
+	record := func(user *github.User, at time.Time, kind int) {
+		login := user.GetLogin()
+		if login == "" || at.Before(since) || filter.Excludes(login, user.GetType()) {
+			return
+		}
+		if members != nil && !members[login] {
+			return
+		}
+		local := at.In(location)
+		cell := cells[heatmapRow(local.Weekday())][local.Hour()]
+		switch kind {
+		case activityCommit:
+			cell.Commits++
+			result.Commits++
+		case activityPROpened:
+			cell.PrsOpened++
+			result.PrsOpened++
+		case activityReview:
+			cell.Reviews++
+			result.Reviews++
+		}
+		cell.Total++
+
+		if contributors[login] == nil {
+			contributors[login] = &response.ContributorActivity{Username: login}
+		}
+		contributors[login].Total++
+		result.Total++
+		if !hours.contains(local) {
+			contributors[login].OutsideWorkingHours++
+			result.OutsideWorkingHours++
+		}
+	}
+
+	commits, err := g.listCommits(owner, repo, since, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch commits: %w", err)
+	}
+	for _, commit := range commits {
+		record(commit.GetAuthor(), commit.GetCommit().GetAuthor().GetDate().Time, activityCommit)
+	}
+
+	prs, err := g.listPullRequests(owner, repo, "all", since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch PRs: %w", err)
+	}
+	for _, pr := range prs {
+		record(pr.GetUser(), pr.GetCreatedAt().Time, activityPROpened)
+
+		reviews, err := g.listReviews(owner, repo, pr.GetNumber())
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch reviews for PR #%d: %w", pr.GetNumber(), err)
+		}
+		for _, review := range reviews {
+			if review.SubmittedAt == nil || review.GetUser().GetLogin() == pr.GetUser().GetLogin() {
+				continue
+			}
+			record(review.GetUser(), review.SubmittedAt.Time, activityReview)
+		}
+	}
+
+	if result.Total > 0 {
+		result.OutsideWorkingHoursPercent = float32(result.OutsideWorkingHours) / float32(result.Total) * 100
+	}
+	for _, c := range contributors {
+		c.OutsideWorkingHoursPercent = float32(c.OutsideWorkingHours) / float32(c.Total) * 100
+		result.Contributors = append(result.Contributors, c)
+	}
+	sort.Slice(result.Contributors, func(i, j int) bool {
+		a, b := result.Contributors[i], result.Contributors[j]
+		if a.OutsideWorkingHoursPercent != b.OutsideWorkingHoursPercent {
+			return a.OutsideWorkingHoursPercent > b.OutsideWorkingHoursPercent
+		}
+		return a.Username < b.Username
+	})
+
+	return result, nil
+}
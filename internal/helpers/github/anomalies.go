@@ -0,0 +1,219 @@
+package github
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/bikash-789/comm-protos/luminex/v1/request"
+	"github.com/bikash-789/comm-protos/luminex/v1/response"
+	"luminex-service/internal/helpers/stats"
+)
+
+const (
+	granularityDaily  = "daily"
+	granularityWeekly = "weekly"
+
+	defaultAnomalyThreshold = 3.5
+	minBaselinePoints       = 5
+
+	severityWarning  = "warning"
+	severityCritical = "critical"
+)
+
+// anomalySettings holds, per granularity, the number of periods analyzed and the trailing
+// periods used as the baseline for each point.
+var anomalySettings = map[string]struct {
+	periods  int
+	baseline int
+}{
+	granularityDaily:  {periods: 90, baseline: 28},
+	granularityWeekly: {periods: 26, baseline: 8},
+}
+
+const (
+	metricPRsOpened    = "prs_opened"
+	metricPRsMerged    = "prs_merged"
+	metricMergeTime    = "median_merge_time_hours"
+	metricIssuesOpened = "issues_opened"
+	metricIssuesClosed = "issues_closed"
+)
+
+// metricSeries holds one value per period; periods without data (e.g. no merges) are missing.
+type metricSeries struct {
+	name    string
+	values  []float64
+	present []bool
+}
+
+func newMetricSeries(name string, periods int, counted bool) *metricSeries {
+	s := &metricSeries{name: name, values: make([]float64, periods), present: make([]bool, periods)}
+	if counted {
+		for i := range s.present {
+			s.present[i] = true
+		}
+	}
+	return s
+}
+
+func (s *metricSeries) set(i int, value float64) {
+	s.values[i] = value
+	s.present[i] = true
+}
+
+func (g *GithubClient) GetAnomalies(req *request.AnomalyRequest, filter *AuthorFilter) (*response.AnomalyResponse, error) {
+	owner := req.Owner
+	repo := req.Repo
+	granularity := req.Granularity
+	if granularity == "" {
+		granularity = granularityWeekly
+	}
+	settings, ok := anomalySettings[granularity]
+	if !ok {
+		return nil, fmt.Errorf("unsupported granularity %q, expected %s or %s", granularity, granularityDaily, granularityWeekly)
+	}
+	periods := settings.periods
+	if req.Periods > 0 {
+		periods = int(req.Periods)
+	}
+	threshold := float64(req.Threshold)
+	if threshold <= 0 {
+		threshold = defaultAnomalyThreshold
+	}
+
+	// The series ends with the last complete period, so a partial day or week is never flagged as a drop.
+	now := time.Now().UTC()
+	periodStart := func(i int) time.Time {
+		if granularity == granularityDaily {
+			return now.Truncate(24*time.Hour).AddDate(0, 0, i-periods)
+		}
+		return startOfWeek(now).AddDate(0, 0, 7*(i-periods))
+	}
+	start := periodStart(0)
+	periodOf := func(t time.Time) int {
+		if t.Before(start) {
+			return -1
+		}
+		days := int(t.UTC().Sub(start).Hours() / 24)
+		if granularity == granularityWeekly {
+			return days / 7
+		}
+		return days
+	}
+
+	opened := newMetricSeries(metricPRsOpened, periods, true)
+	merged := newMetricSeries(metricPRsMerged, periods, true)
+	mergeTime := newMetricSeries(metricMergeTime, periods, false)
+	issuesOpened := newMetricSeries(metricIssuesOpened, periods, true)
+	issuesClosed := newMetricSeries(metricIssuesClosed, periods, true)
+
+	prs, err := g.listPullRequests(owner, repo, "all", start)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch PRs: %w", err)
+	}
+	mergeHours := make([][]float64, periods)
+	for _, pr := range prs {
+		if filter.Excludes(pr.GetUser().GetLogin(), pr.GetUser().GetType()) {
+			continue
+		}
+		if i := periodOf(pr.GetCreatedAt().Time); i >= 0 && i < periods {
+			opened.values[i]++
+		}
+		if pr.MergedAt != nil {
+			if i := periodOf(pr.MergedAt.Time); i >= 0 && i < periods {
+				merged.values[i]++
+				mergeHours[i] = append(mergeHours[i], pr.MergedAt.Time.Sub(pr.GetCreatedAt().Time).Hours())
+			}
+		}
+	}
+	for i, hours := range mergeHours {
+		if len(hours) > 0 {
+			mergeTime.set(i, stats.Median(hours))
+		}
+	}
+
+	issues, err := g.listIssues(owner, repo, "all", start)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch issues: %w", err)
+	}
+	for _, issue := range issues {
+		if filter.Excludes(issue.GetUser().GetLogin(), issue.GetUser().GetType()) {
+			continue
+		}
+		if i := periodOf(issue.GetCreatedAt().Time); i >= 0 && i < periods {
+			issuesOpened.values[i]++
+		}
+		if issue.ClosedAt != nil {
+			if i := periodOf(issue.ClosedAt.Time); i >= 0 && i < periods {
+				issuesClosed.values[i]++
+			}
+		}
+	}
+
+	result := &response.AnomalyResponse{
+		Granularity: granularity,
+		Threshold:   float32(threshold),
+	}
+	for _, series := range []*metricSeries{opened, merged, mergeTime, issuesOpened, issuesClosed} {
+		out := &response.MetricSeries{Metric: series.name}
+		for i := range series.values {
+			if series.present[i] {
+				out.Points = append(out.Points, &response.SeriesPoint{
+					Period: periodStart(i).Format("2006-01-02"),
+					Value:  series.values[i],
+				})
+			}
+		}
+		result.Series = append(result.Series, out)
+		result.Anomalies = append(result.Anomalies, detectAnomalies(out.Points, series.name, settings.baseline, threshold)...)
+	}
+	sort.Slice(result.Anomalies, func(i, j int) bool {
+		if result.Anomalies[i].Period != result.Anomalies[j].Period {
+			return result.Anomalies[i].Period > result.Anomalies[j].Period
+		}
+		return math.Abs(float64(result.Anomalies[i].Score)) > math.Abs(float64(result.Anomalies[j].Score))
+	})
+
+	return result, nil
+}
+
+// detectAnomalies flags points whose robust z-score against the trailing baseline exceeds threshold;
+// twice the threshold is critical.
+func detectAnomalies(points []*response.SeriesPoint, metric string, baselineSize int, threshold float64) []*response.Anomaly {
+	var anomalies []*response.Anomaly
+	for i := minBaselinePoints; i < len(points); i++ {
+		from := i - baselineSize
+		if from < 0 {
+			from = 0
+		}
+		baselinePoints := points[from:i]
+		baseline := make([]float64, 0, len(baselinePoints))
+		for _, p := range baselinePoints {
+			baseline = append(baseline, p.Value)
+		}
+
+		score, ok := stats.RobustZScore(points[i].Value, baseline)
+		if !ok || math.Abs(score) < threshold {
+			continue
+		}
+		anomaly := &response.Anomaly{
+			Metric:         metric,
+			Period:         points[i].Period,
+			Value:          points[i].Value,
+			BaselineMedian: stats.Median(baseline),
+			Score:          float32(score),
+			Direction:      "spike",
+			Severity:       severityWarning,
+			BaselinePoints: baselinePoints,
+		}
+		if score < 0 {
+			anomaly.Direction = "drop"
+		}
+		if math.Abs(score) >= 2*threshold {
+			anomaly.Severity = severityCritical
+		}
+		anomalies = append(anomalies, anomaly)
+	}
+	return anomalies
+}
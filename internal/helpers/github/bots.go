@@ -0,0 +1,129 @@
+package github
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bikash-789/comm-protos/luminex/v1/response"
+	"github.com/google/go-github/v50/github"
+	"luminex-service/internal/conf"
+)
+
+const botUserType = "Bot"
+
+// AuthorFilter recognises automation accounts and decides whether their activity
+// is left out of the human-facing metrics of a request.
+type AuthorFilter struct {
+	matchUserType bool
+	patterns      []*regexp.Regexp
+	logins        map[string]bool
+	includeBots   bool
+}
+
+func NewAuthorFilter(config *conf.BotFilter, includeBots bool) (*AuthorFilter, error) {
+	filter := &AuthorFilter{
+		matchUserType: !config.GetIgnoreUserType(),
+		logins:        make(map[string]bool),
+		includeBots:   includeBots,
+	}
+	for _, login := range config.GetLogins() {
+		filter.logins[strings.ToLower(login)] = true
+	}
+	for _, pattern := range config.GetLoginPatterns() {
+		re, err := loginPattern(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid bot login pattern %q: %w", pattern, err)
+		}
+		filter.patterns = append(filter.patterns, re)
+	}
+	return filter, nil
+}
+
+// loginPattern compiles a case-insensitive glob where * and ? are the only wildcards.
+func loginPattern(glob string) (*regexp.Regexp, error) {
+	var sb strings.Builder
+	sb.WriteString("(?i)^")
+	for _, c := range glob {
+		switch c {
+		case '*':
+			sb.WriteString(".*")
+		case '?':
+			sb.WriteString(".")
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	sb.WriteString("$")
+	return regexp.Compile(sb.String())
+}
+
+func (f *AuthorFilter) IsBot(login, userType string) bool {
+	if f == nil || login == "" {
+		return false
+	}
+	if f.matchUserType && userType == botUserType {
+		return true
+	}
+	if f.logins[strings.ToLower(login)] {
+		return true
+	}
+	for _, re := range f.patterns {
+		if re.MatchString(login) {
+			return true
+		}
+	}
+	return false
+}
+
+// Excludes reports whether activity by the account should be dropped from the metrics.
+func (f *AuthorFilter) Excludes(login, userType string) bool {
+	return f != nil && !f.includeBots && f.IsBot(login, userType)
+}
+
+type automationBreakdown struct {
+	breakdown *response.AutomationBreakdown
+	accounts  map[string]*response.AutomationAccount
+	mergeTime time.Duration
+}
+
+func newAutomationBreakdown() *automationBreakdown {
+	return &automationBreakdown{
+		breakdown: &response.AutomationBreakdown{},
+		accounts:  make(map[string]*response.AutomationAccount),
+	}
+}
+
+func (a *automationBreakdown) add(pr *github.PullRequest) {
+	a.breakdown.TotalPrs++
+	if pr.GetState() == "open" {
+		a.breakdown.OpenPrs++
+	}
+	if pr.MergedAt != nil && pr.CreatedAt != nil {
+		a.breakdown.MergedPrs++
+		a.mergeTime += pr.MergedAt.Time.Sub(pr.CreatedAt.Time)
+	}
+
+	login := pr.GetUser().GetLogin()
+	if a.accounts[login] == nil {
+		a.accounts[login] = &response.AutomationAccount{Username: login}
+	}
+	a.accounts[login].Prs++
+}
+
+func (a *automationBreakdown) toResponse() *response.AutomationBreakdown {
+	a.breakdown.AvgMergeTime = averageDuration(a.mergeTime, int(a.breakdown.MergedPrs))
+	a.breakdown.Accounts = make([]*response.AutomationAccount, 0, len(a.accounts))
+	for _, account := range a.accounts {
+		a.breakdown.Accounts = append(a.breakdown.Accounts, account)
+	}
+	sort.Slice(a.breakdown.Accounts, func(i, j int) bool {
+		if a.breakdown.Accounts[i].Prs != a.breakdown.Accounts[j].Prs {
+			return a.breakdown.Accounts[i].Prs > a.breakdown.Accounts[j].Prs
+		}
+		return a.breakdown.Accounts[i].Username < a.breakdown.Accounts[j].Username
+	})
+	return a.breakdown
+}
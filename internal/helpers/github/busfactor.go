@@ -0,0 +1,256 @@
+package github
+
+import (
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bikash-789/comm-protos/luminex/v1/request"
+	"github.com/bikash-789/comm-protos/luminex/v1/response"
+	"luminex-service/internal/helpers/codeowners"
+)
+
+const (
+	defaultHistoryDays       = 365
+	defaultOwnershipShare    = 0.5
+	defaultDirectoryDepth    = 1
+	defaultOwnerInactiveDays = 90
+	topOwnershipAuthors      = 5
+	rootDirectory            = "/"
+)
+
+type authorship struct {
+	lines map[string]int
+	total int
+}
+
+func (a *authorship) add(author string, lines int) {
+	if a.lines == nil {
+		a.lines = make(map[string]int)
+	}
+	a.lines[author] += lines
+	a.total += lines
+}
+
+// busFactor returns the smallest number of authors that together account for share of the
+// changed lines, along with all authors ranked by their lines.
+func (a *authorship) busFactor(share float64) (int32, []*response.AuthorShare) {
+	authors := make([]*response.AuthorShare, 0, len(a.lines))
+	for author, lines := range a.lines {
+		authors = append(authors, &response.AuthorShare{
+			Username:     author,
+			LinesChanged: int32(lines),
+			Percent:      float32(lines) / float32(a.total) * 100,
+		})
+	}
+	sort.Slice(authors, func(i, j int) bool {
+		if authors[i].LinesChanged != authors[j].LinesChanged {
+			return authors[i].LinesChanged > authors[j].LinesChanged
+		}
+		return authors[i].Username < authors[j].Username
+	})
+
+	var factor int32
+	covered := 0
+	for _, author := range authors {
+		factor++
+		covered += int(author.LinesChanged)
+		if float64(covered) >= share*float64(a.total) {
+			break
+		}
+	}
+	return factor, authors
+}
+
+func directoryAt(file string, depth int) string {
+	dir := path.Dir(file)
+	if dir == "." {
+		return rootDirectory
+	}
+	parts := strings.Split(dir, "/")
+	if len(parts) > depth {
+		parts = parts[:depth]
+	}
+	return strings.Join(parts, "/")
+}
+
+func topAuthors(authors []*response.AuthorShare) []*response.AuthorShare {
+	if len(authors) > topOwnershipAuthors {
+		return authors[:topOwnershipAuthors]
+	}
+	return authors
+}
+
+func (g *GithubClient) GetBusFactor(req *request.BusFactorRequest, filter *AuthorFilter) (*response.BusFactorResponse, error) {
+	owner := req.Owner
+	repo := req.Repo
+	days := req.Days
+	if days <= 0 {
+		days = defaultHistoryDays
+	}
+	share := float64(req.Share)
+	if share <= 0 || share > 1 {
+		share = defaultOwnershipShare
+	}
+	depth := int(req.DirectoryDepth)
+	if depth <= 0 {
+		depth = defaultDirectoryDepth
+	}
+	inactiveDays := req.InactiveDays
+	if inactiveDays <= 0 {
+		inactiveDays = defaultOwnerInactiveDays
+	}
+
+	changes, analyzed, truncated, err := g.commitFileChanges(owner, repo, windowStart(days), filter)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &response.BusFactorResponse{
+		Share:           float32(share),
+		CommitsAnalyzed: int32(analyzed),
+		Truncated:       truncated,
+	}
+
+	repoAuthorship := &authorship{}
+	directories := make(map[string]*authorship)
+	lastActivity := make(map[string]time.Time)
+	for _, change := range changes {
+		repoAuthorship.add(change.author, change.lines)
+		dir := directoryAt(change.path, depth)
+		if directories[dir] == nil {
+			directories[dir] = &authorship{}
+		}
+		directories[dir].add(change.author, change.lines)
+		if change.changed.After(lastActivity[change.author]) {
+			lastActivity[change.author] = change.changed
+		}
+	}
+
+	if repoAuthorship.total > 0 {
+		factor, authors := repoAuthorship.busFactor(share)
+		result.RepoBusFactor = factor
+		result.RepoTopAuthors = topAuthors(authors)
+	}
+
+	for dir, a := range directories {
+		factor, authors := a.busFactor(share)
+		stats := &response.DirectoryBusFactor{
+			Path:         dir,
+			BusFactor:    factor,
+			Authors:      int32(len(authors)),
+			LinesChanged: int32(a.total),
+			TopAuthors:   topAuthors(authors),
+			SingleOwner:  len(authors) == 1,
+		}
+		if stats.SingleOwner {
+			result.SingleOwnerDirectories = append(result.SingleOwnerDirectories, dir)
+		}
+		result.Directories = append(result.Directories, stats)
+	}
+	sort.Slice(result.Directories, func(i, j int) bool {
+		a, b := result.Directories[i], result.Directories[j]
+		if a.BusFactor != b.BusFactor {
+			return a.BusFactor < b.BusFactor
+		}
+		if a.LinesChanged != b.LinesChanged {
+			return a.LinesChanged > b.LinesChanged
+		}
+		return a.Path < b.Path
+	})
+	sort.Strings(result.SingleOwnerDirectories)
+
+	file, location, err := g.fetchCodeOwners(owner, repo)
+	if err != nil {
+		return nil, err
+	}
+	if file != nil {
+		result.CodeownersPath = location
+		inactive, unresolved, err := g.inactiveCodeOwners(owner, repo, file, lastActivity, time.Now().AddDate(0, 0, -int(inactiveDays)))
+		if err != nil {
+			return nil, err
+		}
+		result.InactiveCodeOwners = inactive
+		result.UnresolvedTeams = unresolved
+	}
+
+	return result, nil
+}
+
+// inactiveCodeOwners lists CODEOWNERS users without a commit since cutoff, and teams whose members
+// are all inactive. Email owners cannot be mapped to accounts and are skipped, as are teams that
+// are missing or not visible to the token (e.g. without read:org access), which are returned separately.
+func (g *GithubClient) inactiveCodeOwners(owner, repo string, file *codeowners.File, lastActivity map[string]time.Time, cutoff time.Time) ([]*response.InactiveCodeOwner, []string, error) {
+	patterns := make(map[string][]string)
+	var owners []string
+	for _, rule := range file.Rules {
+		for _, o := range rule.Owners {
+			if patterns[o] == nil {
+				owners = append(owners, o)
+			}
+			patterns[o] = append(patterns[o], rule.Pattern.String())
+		}
+	}
+
+	lastCommit := func(login string) (time.Time, error) {
+		if t, ok := lastActivity[login]; ok {
+			return t, nil
+		}
+		t, err := g.lastCommitAt(owner, repo, login)
+		if err != nil {
+			return time.Time{}, err
+		}
+		lastActivity[login] = t
+		return t, nil
+	}
+
+	var result []*response.InactiveCodeOwner
+	var unresolved []string
+	for _, o := range owners {
+		var last time.Time
+		switch {
+		case codeowners.IsUser(o):
+			t, err := lastCommit(strings.TrimPrefix(o, "@"))
+			if err != nil {
+				return nil, nil, err
+			}
+			last = t
+		case codeowners.IsTeam(o):
+			org, slug := codeowners.TeamSlug(o)
+			team, err := g.resolveTeam(org, slug)
+			if teamNotVisible(err) {
+				unresolved = append(unresolved, o)
+				continue
+			}
+			if err != nil {
+				return nil, nil, err
+			}
+			for member := range team.members {
+				t, err := lastCommit(member)
+				if err != nil {
+					return nil, nil, err
+				}
+				if t.After(last) {
+					last = t
+				}
+			}
+		default:
+			continue
+		}
+		if last.After(cutoff) {
+			continue
+		}
+		inactive := &response.InactiveCodeOwner{
+			Owner:        o,
+			Team:         codeowners.IsTeam(o),
+			Patterns:     patterns[o],
+			LastActivity: "N/A",
+		}
+		if !last.IsZero() {
+			inactive.LastActivity = last.Format("2006-01-02")
+		}
+		result = append(result, inactive)
+	}
+	return result, unresolved, nil
+}
@@ -0,0 +1,299 @@
+package github
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/bikash-789/comm-protos/luminex/v1/request"
+	"github.com/bikash-789/comm-protos/luminex/v1/response"
+	"github.com/google/go-github/v50/github"
+)
+
+const (
+	maxCIJobRuns   = 300
+	maxCICheckRefs = 100
+	topFailingJobs = 10
+
+	// githubActionsApp reports the check runs of workflow jobs, which are already counted from the
+	// Actions API.
+	githubActionsApp = "github-actions"
+)
+
+type ciAggregate struct {
+	workflow   string
+	name       string
+	runs       int
+	successes  int
+	failures   int
+	durations  []time.Duration
+	queueTimes []time.Duration
+	// conclusions of each attempt per commit, in execution order, used for flaky detection
+	attemptsBySHA map[string][]string
+}
+
+func newCIAggregate(workflow, name string) *ciAggregate {
+	return &ciAggregate{workflow: workflow, name: name, attemptsBySHA: make(map[string][]string)}
+}
+
+func (a *ciAggregate) add(sha, conclusion string, queued, started, completed *github.Timestamp) {
+	a.runs++
+	switch conclusion {
+	case "success":
+		a.successes++
+	case "failure", "timed_out":
+		a.failures++
+	}
+	if started != nil && completed != nil {
+		a.durations = append(a.durations, completed.Time.Sub(started.Time))
+	}
+	if queued != nil && started != nil {
+		a.queueTimes = append(a.queueTimes, started.Time.Sub(queued.Time))
+	}
+	a.attemptsBySHA[sha] = append(a.attemptsBySHA[sha], conclusion)
+}
+
+// successRate is the percentage (0-100) of successful runs among those that succeeded or failed,
+// on the same scale as the other rates in the API.
+func (a *ciAggregate) successRate() float32 {
+	if a.successes+a.failures == 0 {
+		return 0
+	}
+	return float32(a.successes) / float32(a.successes+a.failures) * 100
+}
+
+// flakyCommits counts commits where a failed attempt was followed by a successful one.
+func (a *ciAggregate) flakyCommits() int32 {
+	var flaky int32
+	for _, conclusions := range a.attemptsBySHA {
+		failed := false
+		for _, conclusion := range conclusions {
+			if conclusion == "failure" || conclusion == "timed_out" {
+				failed = true
+			} else if conclusion == "success" && failed {
+				flaky++
+				break
+			}
+		}
+	}
+	return flaky
+}
+
+// runQueuedAt is when the latest attempt of run was queued, if known. A re-run keeps the creation
+// time of the first attempt, so its queue time cannot be derived from the run alone.
+func runQueuedAt(run *github.WorkflowRun) *github.Timestamp {
+	if run.GetRunAttempt() > 1 {
+		return nil
+	}
+	return run.CreatedAt
+}
+
+// GetCIMetrics aggregates the completed workflow runs of the window, skipping runs triggered by
+// excluded actors, e.g. dependency bots. Check runs reported by other CI providers on the head
+// commits of the window are included as well, grouped by the app that reported them.
+func (g *GithubClient) GetCIMetrics(req *request.CIMetricsRequest, filter *AuthorFilter) (*response.CIMetricsResponse, error) {
+	owner := req.Owner
+	repo := req.Repo
+	since := windowStart(req.Days)
+
+	runs, err := g.listWorkflowRuns(owner, repo, since, time.Time{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch workflow runs: %w", err)
+	}
+	sort.Slice(runs, func(i, j int) bool {
+		return runs[i].GetCreatedAt().Time.Before(runs[j].GetCreatedAt().Time)
+	})
+
+	overall := newCIAggregate("", "")
+	workflows := make(map[string]*ciAggregate)
+	jobs := make(map[string]*ciAggregate)
+
+	sampled := 0
+	for i, run := range runs {
+		if run.GetStatus() != "completed" || filter.Excludes(run.GetActor().GetLogin(), run.GetActor().GetType()) {
+			continue
+		}
+		workflow := run.GetName()
+		if workflows[workflow] == nil {
+			workflows[workflow] = newCIAggregate(workflow, workflow)
+		}
+		overall.add(run.GetHeadSHA(), run.GetConclusion(), runQueuedAt(run), run.RunStartedAt, run.UpdatedAt)
+		workflows[workflow].add(run.GetHeadSHA(), run.GetConclusion(), runQueuedAt(run), run.RunStartedAt, run.UpdatedAt)
+
+		if len(runs)-i > maxCIJobRuns {
+			continue
+		}
+		sampled++
+		runJobs, err := g.listWorkflowJobs(owner, repo, run.GetID())
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch jobs for run %d: %w", run.GetID(), err)
+		}
+		sort.Slice(runJobs, func(i, j int) bool {
+			return runJobs[i].GetRunAttempt() < runJobs[j].GetRunAttempt()
+		})
+		for _, job := range runJobs {
+			if job.GetStatus() != "completed" || job.GetConclusion() == "skipped" {
+				continue
+			}
+			key := workflow + "/" + job.GetName()
+			if jobs[key] == nil {
+				jobs[key] = newCIAggregate(workflow, job.GetName())
+			}
+			jobs[key].add(run.GetHeadSHA(), job.GetConclusion(), job.CreatedAt, job.StartedAt, job.CompletedAt)
+		}
+	}
+
+	shas, err := g.ciHeadSHAs(owner, repo, since, runs, filter)
+	if err != nil {
+		return nil, err
+	}
+	for _, sha := range shas {
+		checkRuns, err := g.listCheckRuns(owner, repo, sha)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch check runs for %s: %w", sha, err)
+		}
+		sort.Slice(checkRuns, func(i, j int) bool {
+			return checkRuns[i].GetStartedAt().Time.Before(checkRuns[j].GetStartedAt().Time)
+		})
+		for _, check := range checkRuns {
+			app := check.GetApp().GetName()
+			if check.GetApp().GetSlug() == githubActionsApp || check.GetStatus() != "completed" ||
+				check.GetConclusion() == "skipped" || check.GetStartedAt().Time.Before(since) {
+				continue
+			}
+			if workflows[app] == nil {
+				workflows[app] = newCIAggregate(app, app)
+			}
+			key := app + "/" + check.GetName()
+			if jobs[key] == nil {
+				jobs[key] = newCIAggregate(app, check.GetName())
+			}
+			overall.add(sha, check.GetConclusion(), nil, check.StartedAt, check.CompletedAt)
+			workflows[app].add(sha, check.GetConclusion(), nil, check.StartedAt, check.CompletedAt)
+			jobs[key].add(sha, check.GetConclusion(), nil, check.StartedAt, check.CompletedAt)
+		}
+	}
+
+	result := &response.CIMetricsResponse{
+		TotalRuns:       int32(overall.runs),
+		SuccessRate:     overall.successRate(),
+		MedianDuration:  medianDuration(overall.durations),
+		P90Duration:     percentileDuration(overall.durations, 90),
+		MedianQueueTime: medianDuration(overall.queueTimes),
+		SampledRuns:     int32(sampled),
+		Workflows:       make([]*response.CIWorkflowMetrics, 0, len(workflows)),
+		Jobs:            make([]*response.CIJobMetrics, 0, len(jobs)),
+	}
+
+	for _, w := range workflows {
+		result.Workflows = append(result.Workflows, &response.CIWorkflowMetrics{
+			Name:            w.name,
+			Runs:            int32(w.runs),
+			Failures:        int32(w.failures),
+			SuccessRate:     w.successRate(),
+			MedianDuration:  medianDuration(w.durations),
+			P90Duration:     percentileDuration(w.durations, 90),
+			MedianQueueTime: medianDuration(w.queueTimes),
+			FlakyCommits:    w.flakyCommits(),
+		})
+	}
+	sort.Slice(result.Workflows, func(i, j int) bool {
+		return result.Workflows[i].Runs > result.Workflows[j].Runs
+	})
+
+	for _, j := range jobs {
+		job := &response.CIJobMetrics{
+			Workflow:        j.workflow,
+			Name:            j.name,
+			Runs:            int32(j.runs),
+			Failures:        int32(j.failures),
+			SuccessRate:     j.successRate(),
+			MedianDuration:  medianDuration(j.durations),
+			P90Duration:     percentileDuration(j.durations, 90),
+			MedianQueueTime: medianDuration(j.queueTimes),
+			FlakyCommits:    j.flakyCommits(),
+		}
+		result.Jobs = append(result.Jobs, job)
+		if job.FlakyCommits > 0 {
+			result.FlakyJobs = append(result.FlakyJobs, job)
+		}
+	}
+	sort.Slice(result.Jobs, func(i, j int) bool {
+		if result.Jobs[i].Failures != result.Jobs[j].Failures {
+			return result.Jobs[i].Failures > result.Jobs[j].Failures
+		}
+		return result.Jobs[i].Workflow+result.Jobs[i].Name < result.Jobs[j].Workflow+result.Jobs[j].Name
+	})
+	sort.Slice(result.FlakyJobs, func(i, j int) bool {
+		return result.FlakyJobs[i].FlakyCommits > result.FlakyJobs[j].FlakyCommits
+	})
+
+	for _, job := range result.Jobs {
+		if len(result.MostFailingJobs) == topFailingJobs || job.Failures == 0 {
+			break
+		}
+		result.MostFailingJobs = append(result.MostFailingJobs, job)
+	}
+
+	return result, nil
+}
+
+// ciHeadSHAs returns up to maxCICheckRefs of the most recent commits CI ran on in the window: the
+// heads of the workflow runs and of the pull requests opened since, skipping excluded authors.
+func (g *GithubClient) ciHeadSHAs(owner, repo string, since time.Time, runs []*github.WorkflowRun, filter *AuthorFilter) ([]string, error) {
+	prs, err := g.listPullRequests(owner, repo, "all", since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch pull requests: %w", err)
+	}
+
+	seen := make(map[string]bool)
+	var shas []string
+	add := func(sha string) {
+		if sha != "" && !seen[sha] && len(shas) < maxCICheckRefs {
+			seen[sha] = true
+			shas = append(shas, sha)
+		}
+	}
+	for i := len(runs) - 1; i >= 0; i-- {
+		if !filter.Excludes(runs[i].GetActor().GetLogin(), runs[i].GetActor().GetType()) {
+			add(runs[i].GetHeadSHA())
+		}
+	}
+	for _, pr := range prs {
+		if pr.GetCreatedAt().Time.Before(since) || filter.Excludes(pr.GetUser().GetLogin(), pr.GetUser().GetType()) {
+			continue
+		}
+		add(pr.GetHead().GetSHA())
+	}
+	return shas, nil
+}
+
+// CISummary is the run-level CI health, computed without listing the jobs of each run.
+type CISummary struct {
+	Runs           int32
+	SuccessRate    float32 // percentage, 0-100
+	MedianDuration string
+}
+
+// GetCISummary summarises the completed workflow runs created in [since, until); a zero until means
+// now. It only lists runs, one API call per 100 runs, so it is cheap enough for background syncs.
+func (g *GithubClient) GetCISummary(owner, repo string, since, until time.Time) (*CISummary, error) {
+	runs, err := g.listWorkflowRuns(owner, repo, since, until)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch workflow runs: %w", err)
+	}
+
+	overall := newCIAggregate("", "")
+	for _, run := range runs {
+		created := run.GetCreatedAt().Time
+		if run.GetStatus() != "completed" || created.Before(since) || (!until.IsZero() && !created.Before(until)) {
+			continue
+		}
+		overall.add(run.GetHeadSHA(), run.GetConclusion(), runQueuedAt(run), run.RunStartedAt, run.UpdatedAt)
+	}
+	return &CISummary{
+		Runs:           int32(overall.runs),
+		SuccessRate:    overall.successRate(),
+		MedianDuration: medianDuration(overall.durations),
+	}, nil
+}
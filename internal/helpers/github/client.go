@@ -7,7 +7,12 @@ import (
 	"github.com/bikash-789/comm-protos/luminex/v1/response"
 	"github.com/google/go-github/v50/github"
 	"golang.org/x/oauth2"
+	"luminex-service/internal/conf"
+	"luminex-service/internal/helpers/pathmatch"
+	"luminex-service/internal/helpers/stats"
 	"luminex-service/internal/interfaces/entity"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -20,6 +25,7 @@ func NewGithubClient(config entity.GithubConfig) *GithubClient {
 	ctx := context.Background()
 	ts := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: config.Token})
 	tc := oauth2.NewClient(ctx, ts)
+	tc.Transport = newInstrumentedTransport(tc.Transport, config.Token)
 
 	return &GithubClient{
 		client: github.NewClient(tc),
@@ -27,7 +33,7 @@ func NewGithubClient(config entity.GithubConfig) *GithubClient {
 	}
 }
 
-func (g *GithubClient) GetPRMetrics(req *request.RepositoryRequest) (*response.PRMetricsResponse, error) {
+func (g *GithubClient) GetPRMetrics(req *request.RepositoryRequest, filter *AuthorFilter) (*response.PRMetricsResponse, error) {
 	opts := &github.PullRequestListOptions{State: "all", ListOptions: github.ListOptions{PerPage: 100}}
 	owner := req.Owner
 	repo := req.Repo
@@ -41,8 +47,15 @@ func (g *GithubClient) GetPRMetrics(req *request.RepositoryRequest) (*response.P
 	var openCount int
 	var mergedLast7Days int
 	now := time.Now()
+	automation := newAutomationBreakdown()
 
 	for _, pr := range prs {
+		if filter.IsBot(pr.GetUser().GetLogin(), pr.GetUser().GetType()) {
+			automation.add(pr)
+		}
+		if filter.Excludes(pr.GetUser().GetLogin(), pr.GetUser().GetType()) {
+			continue
+		}
 		if pr.State != nil && *pr.State == "open" {
 			openCount++
 		}
@@ -66,10 +79,11 @@ func (g *GithubClient) GetPRMetrics(req *request.RepositoryRequest) (*response.P
 		AvgMergeTime: avg,
 		OpenPrs:      int32(openCount),
 		MergedLast_7: int32(mergedLast7Days),
+		Automation:   automation.toResponse(),
 	}, nil
 }
 
-func (g *GithubClient) GetMonthlyStats(req *request.RepositoryRequest) (*response.MonthlyStatsResponse, error) {
+func (g *GithubClient) GetMonthlyStats(req *request.RepositoryRequest, filter *AuthorFilter) (*response.MonthlyStatsResponse, error) {
 	owner := req.Owner
 	repo := req.Repo
 	data := make([]*response.MonthData, 12)
@@ -92,7 +106,7 @@ func (g *GithubClient) GetMonthlyStats(req *request.RepositoryRequest) (*respons
 	}
 
 	for _, pr := range prs {
-		if pr.CreatedAt == nil {
+		if pr.CreatedAt == nil || filter.Excludes(pr.GetUser().GetLogin(), pr.GetUser().GetType()) {
 			continue
 		}
 
@@ -128,6 +142,9 @@ func (g *GithubClient) GetMonthlyStats(req *request.RepositoryRequest) (*respons
 		if issue.PullRequestLinks != nil || issue.CreatedAt == nil {
 			continue
 		}
+		if filter.Excludes(issue.GetUser().GetLogin(), issue.GetUser().GetType()) {
+			continue
+		}
 
 		issueCreatedTime := issue.CreatedAt.Time
 
@@ -162,53 +179,7 @@ func (g *GithubClient) GetRepoStats(req *request.RepositoryRequest) (*response.R
 	}, nil
 }
 
-func (g *GithubClient) GetContributorStats(req *request.RepositoryRequest) (*response.ContributorStatsResponse, error) {
-	owner := req.Owner
-	repo := req.Repo
-	contributors, _, err := g.client.Repositories.ListContributors(g.ctx, owner, repo, &github.ListContributorsOptions{
-		ListOptions: github.ListOptions{PerPage: 100},
-	})
-	if err != nil {
-		return nil, fmt.Errorf("failed to fetch contributors: %w", err)
-	}
-
-	result := &response.ContributorStatsResponse{
-		TotalContributors: int32(len(contributors)),
-		TopContributors:   make([]*response.ContributorData, 0),
-	}
-
-	limit := 5
-	if len(contributors) < limit {
-		limit = len(contributors)
-	}
-
-	for i := 0; i < limit; i++ {
-		contributor := contributors[i]
-		result.TopContributors = append(result.TopContributors, &response.ContributorData{
-			Username:      contributor.GetLogin(),
-			Contributions: int32(contributor.GetContributions()),
-			AvatarUrl:     contributor.GetAvatarURL(),
-		})
-	}
-
-	thirtyDaysAgo := time.Now().AddDate(0, 0, -30)
-	commitOpts := &github.CommitsListOptions{
-		Since:       thirtyDaysAgo,
-		ListOptions: github.ListOptions{PerPage: 100},
-	}
-
-	commits, _, err := g.client.Repositories.ListCommits(g.ctx, owner, repo, commitOpts)
-	if err != nil {
-		return result, nil
-	}
-
-	result.CommitsLast_30Days = int32(len(commits))
-	result.AvgCommitsPerDay = float32(float64(len(commits)) / 30.0)
-
-	return result, nil
-}
-
-func (g *GithubClient) GetIssueStats(req *request.RepositoryRequest) (*response.IssueStatsResponse, error) {
+func (g *GithubClient) GetIssueStats(req *request.RepositoryRequest, filter *AuthorFilter) (*response.IssueStatsResponse, error) {
 	owner := req.Owner
 	repo := req.Repo
 	issueOpts := &github.IssueListByRepoOptions{
@@ -230,7 +201,7 @@ func (g *GithubClient) GetIssueStats(req *request.RepositoryRequest) (*response.
 	thirtyDaysAgo := time.Now().AddDate(0, 0, -30)
 
 	for _, issue := range issues {
-		if issue.PullRequestLinks != nil {
+		if issue.PullRequestLinks != nil || filter.Excludes(issue.GetUser().GetLogin(), issue.GetUser().GetType()) {
 			continue
 		}
 
@@ -274,21 +245,34 @@ func (g *GithubClient) GetIssueStats(req *request.RepositoryRequest) (*response.
 		result.OldestOpenIssue = "N/A"
 	}
 
+	if err := g.addBacklogHealth(owner, repo, filter, result); err != nil {
+		return nil, err
+	}
+
 	return result, nil
 }
 
-func (g *GithubClient) GetDetailedPRMetrics(req *request.RepositoryRequest) (*response.DetailedPRStatsResponse, error) {
+func (g *GithubClient) GetDetailedPRMetrics(req *request.RepositoryRequest, sizeSettings *conf.PRSizeSettings, filter *AuthorFilter) (*response.DetailedPRStatsResponse, error) {
 	owner := req.Owner
 	repo := req.Repo
-	basicStatsResp, err := g.GetPRMetrics(req)
+	basicStatsResp, err := g.GetPRMetrics(req, filter)
 	if err != nil {
 		return nil, err
 	}
 
+	excluded, err := pathmatch.CompileAll(sizeSettings.ExcludeGlobs)
+	if err != nil {
+		return nil, fmt.Errorf("invalid PR size exclusion glob: %w", err)
+	}
+
 	result := &response.DetailedPRStatsResponse{
-		AvgMergeTime: basicStatsResp.AvgMergeTime,
-		OpenPrs:      basicStatsResp.OpenPrs,
-		MergedLast_7: basicStatsResp.MergedLast_7,
+		AvgMergeTime:   basicStatsResp.AvgMergeTime,
+		OpenPrs:        basicStatsResp.OpenPrs,
+		MergedLast_7:   basicStatsResp.MergedLast_7,
+		Automation:     basicStatsResp.Automation,
+		SmallMaxLines:  sizeSettings.SmallMaxLines,
+		MediumMaxLines: sizeSettings.MediumMaxLines,
+		ExcludeGlobs:   sizeSettings.ExcludeGlobs,
 	}
 
 	opts := &github.PullRequestListOptions{
@@ -301,36 +285,184 @@ func (g *GithubClient) GetDetailedPRMetrics(req *request.RepositoryRequest) (*re
 		return nil, fmt.Errorf("failed to fetch PRs: %w", err)
 	}
 
+	buckets := []*prSizeBucket{{name: "small"}, {name: "medium"}, {name: "large"}}
 	var totalComments int
 	var prsWithComments int
+	var totalLines int
+	var sizes, reviewComments, mergedSizes, mergeHours []float64
 
+	var counted []*github.PullRequest
 	for _, pr := range prs {
-		if pr.ChangedFiles == nil {
-			continue
+		if !filter.Excludes(pr.GetUser().GetLogin(), pr.GetUser().GetType()) {
+			counted = append(counted, pr)
 		}
+	}
+	details, err := g.pullRequestDetails(owner, repo, counted, excluded)
+	if err != nil {
+		return nil, err
+	}
 
-		changedFiles := *pr.ChangedFiles
-		if changedFiles < 10 {
+	for _, pr := range counted {
+		detail := details[pr.GetNumber()]
+		lines := detail.lines
+		totalLines += lines
+
+		var bucket *prSizeBucket
+		if lines <= int(sizeSettings.SmallMaxLines) {
 			result.SmallPrs++
-		} else if changedFiles <= 30 {
+			bucket = buckets[0]
+		} else if lines <= int(sizeSettings.MediumMaxLines) {
 			result.MediumPrs++
+			bucket = buckets[1]
 		} else {
 			result.LargePrs++
+			bucket = buckets[2]
 		}
+		bucket.count++
+		bucket.lines += lines
+		bucket.reviewComments += detail.reviewComments
 
-		if pr.ReviewComments != nil && *pr.ReviewComments == 0 && pr.State != nil && *pr.State == "closed" {
+		if detail.reviewComments == 0 && pr.GetState() == "closed" {
 			result.PrsWithoutReview++
 		}
 
-		if pr.Comments != nil && *pr.Comments > 0 {
-			totalComments += *pr.Comments
+		if detail.comments > 0 {
+			totalComments += detail.comments
 			prsWithComments++
 		}
+
+		sizes = append(sizes, float64(lines))
+		reviewComments = append(reviewComments, float64(detail.reviewComments))
+		if pr.MergedAt != nil && pr.CreatedAt != nil {
+			mergeTime := pr.MergedAt.Time.Sub(pr.CreatedAt.Time)
+			bucket.mergeTime += mergeTime
+			bucket.merged++
+			mergedSizes = append(mergedSizes, float64(lines))
+			mergeHours = append(mergeHours, mergeTime.Hours())
+		}
 	}
 
 	if prsWithComments > 0 {
 		result.AvgComments = int32(totalComments / prsWithComments)
 	}
+	if len(sizes) > 0 {
+		result.AvgLinesChanged = int32(totalLines / len(sizes))
+	}
+	result.SizeMergeTimeCorrelation = float32(stats.Pearson(mergedSizes, mergeHours))
+	result.SizeReviewCommentsCorrelation = float32(stats.Pearson(sizes, reviewComments))
+
+	for _, bucket := range buckets {
+		result.SizeBuckets = append(result.SizeBuckets, bucket.toResponse())
+	}
 
 	return result, nil
 }
+
+type prSizeBucket struct {
+	name           string
+	count          int
+	lines          int
+	reviewComments int
+	merged         int
+	mergeTime      time.Duration
+}
+
+func (b *prSizeBucket) toResponse() *response.PRSizeBucket {
+	bucket := &response.PRSizeBucket{
+		Size:         b.name,
+		Count:        int32(b.count),
+		AvgMergeTime: averageDuration(b.mergeTime, b.merged),
+	}
+	if b.count > 0 {
+		bucket.AvgLinesChanged = int32(b.lines / b.count)
+		bucket.AvgReviewComments = float32(b.reviewComments) / float32(b.count)
+	}
+	return bucket
+}
+
+// prDetails holds the fields of a PR that the list endpoint leaves out.
+type prDetails struct {
+	lines          int
+	comments       int
+	reviewComments int
+}
+
+// pullRequestDetails makes one request per PR: the PR itself, or its files when paths are excluded
+// from the size, in which case comment counts come from the repository-wide comment lists.
+func (g *GithubClient) pullRequestDetails(owner, repo string, prs []*github.PullRequest, excluded []*pathmatch.Pattern) (map[int]*prDetails, error) {
+	details := make(map[int]*prDetails, len(prs))
+	if len(excluded) == 0 {
+		for _, listed := range prs {
+			pr, _, err := g.client.PullRequests.Get(g.ctx, owner, repo, listed.GetNumber())
+			if err != nil {
+				return nil, fmt.Errorf("failed to fetch PR #%d: %w", listed.GetNumber(), err)
+			}
+			details[pr.GetNumber()] = &prDetails{
+				lines:          pr.GetAdditions() + pr.GetDeletions(),
+				comments:       pr.GetComments(),
+				reviewComments: pr.GetReviewComments(),
+			}
+		}
+		return details, nil
+	}
+	if len(prs) == 0 {
+		return details, nil
+	}
+
+	// Comments on a PR are never older than the PR itself.
+	oldest := prs[0].GetCreatedAt().Time
+	for _, pr := range prs {
+		if created := pr.GetCreatedAt().Time; created.Before(oldest) {
+			oldest = created
+		}
+	}
+	comments, err := g.listRepoIssueComments(owner, repo, oldest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch comments: %w", err)
+	}
+	reviewComments, err := g.listRepoReviewComments(owner, repo, oldest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch review comments: %w", err)
+	}
+
+	for _, pr := range prs {
+		lines, err := g.countChangedLines(owner, repo, pr.GetNumber(), excluded)
+		if err != nil {
+			return nil, err
+		}
+		details[pr.GetNumber()] = &prDetails{lines: lines}
+	}
+	for _, comment := range comments {
+		if detail := details[numberFromURL(comment.GetIssueURL())]; detail != nil {
+			detail.comments++
+		}
+	}
+	for _, comment := range reviewComments {
+		if detail := details[numberFromURL(comment.GetPullRequestURL())]; detail != nil {
+			detail.reviewComments++
+		}
+	}
+	return details, nil
+}
+
+// numberFromURL returns the issue or PR number that ends an API URL, or 0.
+func numberFromURL(url string) int {
+	n, _ := strconv.Atoi(url[strings.LastIndex(url, "/")+1:])
+	return n
+}
+
+func (g *GithubClient) countChangedLines(owner, repo string, number int, excluded []*pathmatch.Pattern) (int, error) {
+	files, err := g.listPRFiles(owner, repo, number)
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch files for PR #%d: %w", number, err)
+	}
+
+	var lines int
+	for _, file := range files {
+		if pathmatch.MatchAny(excluded, file.GetFilename()) {
+			continue
+		}
+		lines += file.GetAdditions() + file.GetDeletions()
+	}
+	return lines, nil
+}
@@ -0,0 +1,205 @@
+package github
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/bikash-789/comm-protos/luminex/v1/request"
+	"github.com/bikash-789/comm-protos/luminex/v1/response"
+	"github.com/google/go-github/v50/github"
+)
+
+const defaultTopContributors = 5
+
+var contributorSortFields = map[string]func(*response.ContributorData) int32{
+	"commits":       func(c *response.ContributorData) int32 { return c.Commits },
+	"lines_added":   func(c *response.ContributorData) int32 { return c.LinesAdded },
+	"lines_removed": func(c *response.ContributorData) int32 { return c.LinesRemoved },
+	"prs_opened":    func(c *response.ContributorData) int32 { return c.PrsOpened },
+	"prs_merged":    func(c *response.ContributorData) int32 { return c.PrsMerged },
+	"reviews_given": func(c *response.ContributorData) int32 { return c.ReviewsGiven },
+	"issues_closed": func(c *response.ContributorData) int32 { return c.IssuesClosed },
+}
+
+func contributorSortKeys() string {
+	keys := make([]string, 0, len(contributorSortFields))
+	for key := range contributorSortFields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return strings.Join(keys, ", ")
+}
+
+func (g *GithubClient) GetContributorStats(req *request.ContributorStatsRequest, filter *AuthorFilter) (*response.ContributorStatsResponse, error) {
+	owner := req.Owner
+	repo := req.Repo
+	sortBy := req.SortBy
+	if sortBy == "" {
+		sortBy = "commits"
+	}
+	sortField, ok := contributorSortFields[sortBy]
+	if !ok {
+		return nil, fmt.Errorf("unsupported sort_by %q, expected one of %s", sortBy, contributorSortKeys())
+	}
+	topN := int(req.TopN)
+	if topN <= 0 {
+		topN = defaultTopContributors
+	}
+	days := req.Days
+	if days <= 0 {
+		days = defaultWindowDays
+	}
+	since := windowStart(days)
+	last30Days := windowStart(30)
+
+	contributors, err := g.listContributors(owner, repo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch contributors: %w", err)
+	}
+
+	result := &response.ContributorStatsResponse{
+		TopContributors: make([]*response.ContributorData, 0),
+		BotContributors: make([]*response.ContributorData, 0),
+		WindowDays:      days,
+		SortBy:          sortBy,
+	}
+
+	activity := make(map[string]*response.ContributorData)
+	contributions := make(map[string]int32)
+	for _, contributor := range contributors {
+		if filter.IsBot(contributor.GetLogin(), contributor.GetType()) {
+			result.BotContributors = append(result.BotContributors, &response.ContributorData{
+				Username:      contributor.GetLogin(),
+				Contributions: int32(contributor.GetContributions()),
+				AvatarUrl:     contributor.GetAvatarURL(),
+			})
+		}
+		if !filter.Excludes(contributor.GetLogin(), contributor.GetType()) {
+			result.TotalContributors++
+			contributions[contributor.GetLogin()] = int32(contributor.GetContributions())
+		}
+	}
+	contributor := func(user *github.User) *response.ContributorData {
+		login := user.GetLogin()
+		if login == "" || filter.Excludes(login, user.GetType()) {
+			return nil
+		}
+		if activity[login] == nil {
+			activity[login] = &response.ContributorData{
+				Username:      login,
+				Contributions: contributions[login],
+				AvatarUrl:     user.GetAvatarURL(),
+			}
+		}
+		return activity[login]
+	}
+
+	commitsSince := since
+	if last30Days.Before(commitsSince) {
+		commitsSince = last30Days
+	}
+	commits, err := g.listCommits(owner, repo, commitsSince, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch commits: %w", err)
+	}
+	for _, commit := range commits {
+		if filter.Excludes(commit.GetAuthor().GetLogin(), commit.GetAuthor().GetType()) {
+			continue
+		}
+		committedAt := commit.GetCommit().GetAuthor().GetDate().Time
+		if committedAt.After(last30Days) {
+			result.CommitsLast_30Days++
+		}
+		if committedAt.Before(since) {
+			continue
+		}
+		result.TotalCommits++
+		if c := contributor(commit.GetAuthor()); c != nil {
+			c.Commits++
+		}
+	}
+	result.AvgCommitsPerDay = float32(float64(result.TotalCommits) / float64(days))
+
+	// Line counts come from GitHub's weekly contributor statistics, so every week overlapping
+	// the window is included. While GitHub is still computing them, lines are left out and flagged.
+	weeks, ready, err := g.listContributorWeeks(owner, repo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch contributor statistics: %w", err)
+	}
+	result.LineStatsPending = !ready
+	for _, stats := range weeks {
+		author := stats.GetAuthor()
+		for _, week := range stats.Weeks {
+			if week.GetWeek().Time.AddDate(0, 0, 7).Before(since) || week.GetAdditions()+week.GetDeletions() == 0 {
+				continue
+			}
+			if c := contributor(&github.User{Login: author.Login, Type: author.Type, AvatarURL: author.AvatarURL}); c != nil {
+				c.LinesAdded += int32(week.GetAdditions())
+				c.LinesRemoved += int32(week.GetDeletions())
+			}
+		}
+	}
+
+	prs, err := g.listPullRequests(owner, repo, "all", since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch PRs: %w", err)
+	}
+	for _, pr := range prs {
+		if c := contributor(pr.GetUser()); c != nil {
+			if pr.GetCreatedAt().Time.After(since) {
+				c.PrsOpened++
+			}
+			if pr.MergedAt != nil && pr.MergedAt.Time.After(since) {
+				c.PrsMerged++
+			}
+		}
+
+		reviews, err := g.listReviews(owner, repo, pr.GetNumber())
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch reviews for PR #%d: %w", pr.GetNumber(), err)
+		}
+		for _, review := range reviews {
+			if review.SubmittedAt == nil || review.SubmittedAt.Time.Before(since) {
+				continue
+			}
+			if review.GetUser().GetLogin() == pr.GetUser().GetLogin() {
+				continue
+			}
+			if c := contributor(review.GetUser()); c != nil {
+				c.ReviewsGiven++
+			}
+		}
+	}
+
+	events, err := g.listIssueEvents(owner, repo, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch issue events: %w", err)
+	}
+	for _, event := range events {
+		if event.GetEvent() != "closed" || event.GetIssue().IsPullRequest() {
+			continue
+		}
+		if c := contributor(event.GetActor()); c != nil {
+			c.IssuesClosed++
+		}
+	}
+
+	ranked := make([]*response.ContributorData, 0, len(activity))
+	for _, c := range activity {
+		ranked = append(ranked, c)
+	}
+	result.ActiveContributors = int32(len(ranked))
+	sort.Slice(ranked, func(i, j int) bool {
+		if sortField(ranked[i]) != sortField(ranked[j]) {
+			return sortField(ranked[i]) > sortField(ranked[j])
+		}
+		return ranked[i].Username < ranked[j].Username
+	})
+	if len(ranked) > topN {
+		ranked = ranked[:topN]
+	}
+	result.TopContributors = append(result.TopContributors, ranked...)
+
+	return result, nil
+}
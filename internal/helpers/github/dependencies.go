@@ -0,0 +1,155 @@
+package github
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bikash-789/comm-protos/luminex/v1/request"
+	"github.com/bikash-789/comm-protos/luminex/v1/response"
+	"github.com/google/go-github/v50/github"
+	"luminex-service/internal/conf"
+)
+
+const (
+	dependenciesLabel = "dependencies"
+	unknownEcosystem  = "unknown"
+	dependabotBranch  = "dependabot/"
+	renovateBranch    = "renovate/"
+)
+
+// ecosystemLabels maps the language labels added by Dependabot and common Renovate presets to an ecosystem.
+var ecosystemLabels = map[string]string{
+	"javascript":     "npm_and_yarn",
+	"npm":            "npm_and_yarn",
+	"go":             "go_modules",
+	"python":         "pip",
+	"ruby":           "bundler",
+	"java":           "maven",
+	"gradle":         "gradle",
+	"rust":           "cargo",
+	"php":            "composer",
+	"docker":         "docker",
+	"github_actions": "github_actions",
+	"terraform":      "terraform",
+	".net":           "nuget",
+	"nuget":          "nuget",
+}
+
+type ecosystemActivity struct {
+	stats     *response.EcosystemStats
+	mergeTime []time.Duration
+}
+
+func isDependencyUpdate(pr *github.PullRequest, filter *AuthorFilter) bool {
+	if !filter.IsBot(pr.GetUser().GetLogin(), pr.GetUser().GetType()) {
+		return false
+	}
+	ref := pr.GetHead().GetRef()
+	if strings.HasPrefix(ref, dependabotBranch) || strings.HasPrefix(ref, renovateBranch) {
+		return true
+	}
+	for _, label := range pr.Labels {
+		if strings.EqualFold(label.GetName(), dependenciesLabel) {
+			return true
+		}
+	}
+	return false
+}
+
+// dependencyEcosystem reads the ecosystem from Dependabot branch names
+// (dependabot/<ecosystem>/<dependency>) and falls back to the PR labels.
+func dependencyEcosystem(pr *github.PullRequest) string {
+	ref := pr.GetHead().GetRef()
+	if strings.HasPrefix(ref, dependabotBranch) {
+		if parts := strings.SplitN(ref, "/", 3); len(parts) == 3 {
+			return parts[1]
+		}
+	}
+	for _, label := range pr.Labels {
+		if ecosystem, ok := ecosystemLabels[strings.ToLower(label.GetName())]; ok {
+			return ecosystem
+		}
+	}
+	return unknownEcosystem
+}
+
+func (g *GithubClient) GetDependencyUpdates(req *request.DependencyUpdatesRequest, thresholds *conf.StaleThresholds, filter *AuthorFilter) (*response.DependencyUpdatesResponse, error) {
+	owner := req.Owner
+	repo := req.Repo
+	since := windowStart(req.Days)
+	now := time.Now()
+
+	openPRs, err := g.listPullRequests(owner, repo, "open", time.Time{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch open PRs: %w", err)
+	}
+	closedPRs, err := g.listPullRequests(owner, repo, "closed", since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch closed PRs: %w", err)
+	}
+
+	result := &response.DependencyUpdatesResponse{IgnoredAfterDays: thresholds.InactiveDays}
+	ecosystems := make(map[string]*ecosystemActivity)
+	ecosystem := func(name string) *ecosystemActivity {
+		if ecosystems[name] == nil {
+			ecosystems[name] = &ecosystemActivity{stats: &response.EcosystemStats{Name: name}}
+		}
+		return ecosystems[name]
+	}
+
+	var mergeTimes []time.Duration
+	for _, pr := range append(openPRs, closedPRs...) {
+		if !isDependencyUpdate(pr, filter) {
+			continue
+		}
+		if pr.GetState() != "open" && pr.GetClosedAt().Time.Before(since) {
+			continue
+		}
+		e := ecosystem(dependencyEcosystem(pr))
+
+		switch {
+		case pr.GetState() == "open":
+			result.OpenPrs++
+			e.stats.OpenPrs++
+			age := int32(now.Sub(pr.GetCreatedAt().Time).Hours() / 24)
+			if age > e.stats.OldestOpenDays {
+				e.stats.OldestOpenDays = age
+			}
+			if int32(now.Sub(pr.GetUpdatedAt().Time).Hours()/24) >= thresholds.InactiveDays {
+				result.IgnoredPrs++
+				e.stats.IgnoredPrs++
+			}
+		case pr.MergedAt != nil:
+			mergeTime := pr.MergedAt.Time.Sub(pr.GetCreatedAt().Time)
+			result.MergedPrs++
+			e.stats.MergedPrs++
+			mergeTimes = append(mergeTimes, mergeTime)
+			e.mergeTime = append(e.mergeTime, mergeTime)
+		default:
+			result.ClosedUnmerged++
+			e.stats.ClosedUnmerged++
+		}
+	}
+
+	result.MedianTimeToMerge = medianDuration(mergeTimes)
+	result.Ecosystems = make([]*response.EcosystemStats, 0, len(ecosystems))
+	for _, e := range ecosystems {
+		e.stats.MedianTimeToMerge = medianDuration(e.mergeTime)
+		e.stats.Lagging = e.stats.IgnoredPrs > 0 || e.stats.OldestOpenDays >= thresholds.InactiveDays
+		result.Ecosystems = append(result.Ecosystems, e.stats)
+	}
+	sort.Slice(result.Ecosystems, func(i, j int) bool {
+		a, b := result.Ecosystems[i], result.Ecosystems[j]
+		if a.Lagging != b.Lagging {
+			return a.Lagging
+		}
+		if a.OldestOpenDays != b.OldestOpenDays {
+			return a.OldestOpenDays > b.OldestOpenDays
+		}
+		return a.Name < b.Name
+	})
+
+	return result, nil
+}
@@ -0,0 +1,89 @@
+package github
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/go-github/v50/github"
+)
+
+type WeeklyActivity struct {
+	MergedPRs    []*github.PullRequest
+	IssuesOpened int
+	IssuesClosed int
+	OpenIssues   int
+	// Members is the resolved team, or nil when the digest covers the whole repository.
+	Members map[string]bool
+}
+
+// GetWeeklyActivity collects the PRs merged and the issues opened and closed between since and until,
+// restricted to PRs authored by, and issues assigned to, the team ("org/slug") when one is given.
+func (g *GithubClient) GetWeeklyActivity(owner, repo, team string, since, until time.Time, filter *AuthorFilter) (*WeeklyActivity, error) {
+	members, err := g.teamMembers(team)
+	if err != nil {
+		return nil, err
+	}
+	activity := &WeeklyActivity{Members: members}
+	member := func(login string) bool {
+		return activity.Members == nil || activity.Members[login]
+	}
+	inPeriod := func(t time.Time) bool {
+		return !t.Before(since) && t.Before(until)
+	}
+
+	prs, err := g.listPullRequests(owner, repo, "closed", since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch PRs: %w", err)
+	}
+	for _, pr := range prs {
+		if pr.MergedAt == nil || !inPeriod(pr.MergedAt.Time) {
+			continue
+		}
+		if filter.Excludes(pr.GetUser().GetLogin(), pr.GetUser().GetType()) || !member(pr.GetUser().GetLogin()) {
+			continue
+		}
+		activity.MergedPRs = append(activity.MergedPRs, pr)
+	}
+	sort.Slice(activity.MergedPRs, func(i, j int) bool {
+		return activity.MergedPRs[i].MergedAt.Time.After(activity.MergedPRs[j].MergedAt.Time)
+	})
+
+	openIssues, err := g.listIssues(owner, repo, "open", time.Time{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch open issues: %w", err)
+	}
+	recentIssues, err := g.listIssues(owner, repo, "all", since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch recent issues: %w", err)
+	}
+	assigned := func(issue *github.Issue) bool {
+		if activity.Members == nil {
+			return true
+		}
+		for _, assignee := range issue.Assignees {
+			if activity.Members[assignee.GetLogin()] {
+				return true
+			}
+		}
+		return false
+	}
+	for _, issue := range openIssues {
+		if !filter.Excludes(issue.GetUser().GetLogin(), issue.GetUser().GetType()) && assigned(issue) {
+			activity.OpenIssues++
+		}
+	}
+	for _, issue := range recentIssues {
+		if filter.Excludes(issue.GetUser().GetLogin(), issue.GetUser().GetType()) || !assigned(issue) {
+			continue
+		}
+		if inPeriod(issue.GetCreatedAt().Time) {
+			activity.IssuesOpened++
+		}
+		if issue.ClosedAt != nil && inPeriod(issue.ClosedAt.Time) {
+			activity.IssuesClosed++
+		}
+	}
+
+	return activity, nil
+}
@@ -0,0 +1,248 @@
+package github
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/bikash-789/comm-protos/luminex/v1/request"
+	"github.com/bikash-789/comm-protos/luminex/v1/response"
+	"github.com/google/go-github/v50/github"
+)
+
+const (
+	defaultForecastHistoryWeeks = 12
+	defaultSimulations          = 10000
+	maxSimulations              = 100000
+	maxForecastWeeks            = 520
+)
+
+var forecastConfidences = []int{50, 85, 95}
+
+// weeksToFinish simulates how many weeks it takes to close remaining items by replaying randomly
+// drawn historical weeks; it gives up after maxForecastWeeks.
+func weeksToFinish(rng *rand.Rand, throughput []int, remaining int) int {
+	done := 0
+	for week := 1; week <= maxForecastWeeks; week++ {
+		done += throughput[rng.Intn(len(throughput))]
+		if done >= remaining {
+			return week
+		}
+	}
+	return maxForecastWeeks
+}
+
+// itemsWithin simulates how many items are closed in a number of weeks; a partial last week
+// contributes its share of a randomly drawn week.
+func itemsWithin(rng *rand.Rand, throughput []int, weeks float64) int {
+	done := 0
+	full := int(weeks)
+	for week := 0; week < full; week++ {
+		done += throughput[rng.Intn(len(throughput))]
+	}
+	if partial := weeks - float64(full); partial > 0 {
+		done += int(float64(throughput[rng.Intn(len(throughput))]) * partial)
+	}
+	return done
+}
+
+// sortedOutcome returns the value reached by at least confidence percent of the sorted simulations.
+func sortedOutcome(sorted []int, confidence int, ascending bool) int {
+	i := (len(sorted) - 1) * confidence / 100
+	if !ascending {
+		i = len(sorted) - 1 - i
+	}
+	return sorted[i]
+}
+
+func (g *GithubClient) GetForecast(req *request.ForecastRequest, filter *AuthorFilter) (*response.ForecastResponse, error) {
+	owner := req.Owner
+	repo := req.Repo
+	historyWeeks := int(req.HistoryWeeks)
+	if historyWeeks <= 0 {
+		historyWeeks = defaultForecastHistoryWeeks
+	}
+	simulations := int(req.Simulations)
+	if simulations <= 0 {
+		simulations = defaultSimulations
+	}
+	if simulations > maxSimulations {
+		simulations = maxSimulations
+	}
+
+	now := time.Now()
+	result := &response.ForecastResponse{
+		HistoryWeeks: int32(historyWeeks),
+		Simulations:  int32(simulations),
+	}
+
+	// The target day counts in full, so a target of today still forecasts the rest of the day.
+	var targetWeeks float64
+	if req.TargetDate != "" {
+		target, err := time.ParseInLocation("2006-01-02", req.TargetDate, now.Location())
+		if err != nil {
+			return nil, fmt.Errorf("invalid target_date %q, expected YYYY-MM-DD: %w", req.TargetDate, err)
+		}
+		end := target.AddDate(0, 0, 1)
+		if !end.After(now) {
+			return nil, fmt.Errorf("target_date %s is in the past", req.TargetDate)
+		}
+		result.TargetDate = req.TargetDate
+		targetWeeks = end.Sub(now).Hours() / (24 * 7)
+	}
+
+	// The remaining work is the explicit item count, else the milestone's open issues and PRs, else
+	// the repository's open issues.
+	remaining := int(req.Items)
+	if req.Milestone > 0 {
+		milestone, _, err := g.client.Issues.GetMilestone(g.ctx, owner, repo, int(req.Milestone))
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch milestone %d: %w", req.Milestone, err)
+		}
+		result.Milestone = milestone.GetTitle()
+		if remaining <= 0 {
+			if remaining, err = g.openMilestoneItems(owner, repo, int(req.Milestone), filter); err != nil {
+				return nil, err
+			}
+			if remaining == 0 {
+				return completedForecast(result, now), nil
+			}
+		}
+	}
+	if remaining <= 0 {
+		openIssues, err := g.listIssues(owner, repo, "open", time.Time{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch open issues: %w", err)
+		}
+		for _, issue := range openIssues {
+			if !filter.Excludes(issue.GetUser().GetLogin(), issue.GetUser().GetType()) {
+				remaining++
+			}
+		}
+	}
+	result.RemainingItems = int32(remaining)
+
+	// Throughput is sampled from complete weeks only.
+	historyStart := startOfWeek(now).AddDate(0, 0, -7*historyWeeks)
+	throughput, err := g.weeklyClosed(owner, repo, int(req.Milestone), historyStart, historyWeeks, filter)
+	if err != nil {
+		return nil, err
+	}
+	total := 0
+	for _, closed := range throughput {
+		result.WeeklyThroughput = append(result.WeeklyThroughput, int32(closed))
+		total += closed
+	}
+	if total == 0 {
+		return nil, fmt.Errorf("no items were closed in the last %d weeks, cannot forecast", historyWeeks)
+	}
+
+	rng := rand.New(rand.NewSource(now.UnixNano()))
+	if remaining > 0 {
+		weeks := make([]int, simulations)
+		for i := range weeks {
+			weeks[i] = weeksToFinish(rng, throughput, remaining)
+		}
+		sort.Ints(weeks)
+		for _, confidence := range forecastConfidences {
+			w := sortedOutcome(weeks, confidence, true)
+			result.CompletionDates = append(result.CompletionDates, &response.ForecastDate{
+				Confidence: int32(confidence),
+				Weeks:      int32(w),
+				Date:       now.AddDate(0, 0, 7*w).Format("2006-01-02"),
+			})
+		}
+	}
+
+	if req.TargetDate != "" {
+		items := make([]int, simulations)
+		for i := range items {
+			items[i] = itemsWithin(rng, throughput, targetWeeks)
+		}
+		sort.Ints(items)
+		for _, confidence := range forecastConfidences {
+			result.ItemsByTarget = append(result.ItemsByTarget, &response.ForecastCount{
+				Confidence: int32(confidence),
+				Items:      int32(sortedOutcome(items, confidence, false)),
+			})
+		}
+	}
+
+	return result, nil
+}
+
+// completedForecast reports a milestone with nothing left open as done today at every confidence,
+// with no items left to close by the target date.
+func completedForecast(result *response.ForecastResponse, now time.Time) *response.ForecastResponse {
+	for _, confidence := range forecastConfidences {
+		result.CompletionDates = append(result.CompletionDates, &response.ForecastDate{
+			Confidence: int32(confidence),
+			Date:       now.Format("2006-01-02"),
+		})
+		if result.TargetDate != "" {
+			result.ItemsByTarget = append(result.ItemsByTarget, &response.ForecastCount{Confidence: int32(confidence)})
+		}
+	}
+	return result
+}
+
+// openMilestoneItems counts the open issues and PRs of a milestone, skipping excluded authors as
+// weeklyClosed does.
+func (g *GithubClient) openMilestoneItems(owner, repo string, milestone int, filter *AuthorFilter) (int, error) {
+	opts := &github.IssueListByRepoOptions{
+		State:       "open",
+		Milestone:   fmt.Sprint(milestone),
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+
+	open := 0
+	for {
+		issues, resp, err := g.client.Issues.ListByRepo(g.ctx, owner, repo, opts)
+		if err != nil {
+			return 0, fmt.Errorf("failed to fetch open items of milestone %d: %w", milestone, err)
+		}
+		for _, issue := range issues {
+			if !filter.Excludes(issue.GetUser().GetLogin(), issue.GetUser().GetType()) {
+				open++
+			}
+		}
+		if resp.NextPage == 0 {
+			return open, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// weeklyClosed counts the issues closed in each week since start, restricted to a milestone when
+// one is given. Milestone counts include PRs, as milestones track both.
+func (g *GithubClient) weeklyClosed(owner, repo string, milestone int, start time.Time, weeks int, filter *AuthorFilter) ([]int, error) {
+	opts := &github.IssueListByRepoOptions{
+		State:       "closed",
+		Since:       start,
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+	if milestone > 0 {
+		opts.Milestone = fmt.Sprint(milestone)
+	}
+
+	closed := make([]int, weeks)
+	for {
+		issues, resp, err := g.client.Issues.ListByRepo(g.ctx, owner, repo, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch closed issues: %w", err)
+		}
+		for _, issue := range issues {
+			if issue.ClosedAt == nil || (milestone == 0 && issue.IsPullRequest()) || filter.Excludes(issue.GetUser().GetLogin(), issue.GetUser().GetType()) {
+				continue
+			}
+			if week := int(issue.ClosedAt.Time.Sub(start).Hours() / (24 * 7)); week >= 0 && week < weeks {
+				closed[week]++
+			}
+		}
+		if resp.NextPage == 0 {
+			return closed, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
@@ -0,0 +1,119 @@
+package github
+
+import (
+	"fmt"
+	"time"
+
+	"luminex-service/internal/helpers/stats"
+)
+
+// Rates are percentages from 0 to 100, so a ci_success_rate target of 90 means 90%.
+const (
+	goalMergeTimeP50Hours  = "merge_time_p50_hours"
+	goalMergeTimeP90Hours  = "merge_time_p90_hours"
+	goalMaxReviewWaitHours = "max_review_wait_hours"
+	goalOpenIssues         = "open_issues"
+	goalOpenPRs            = "open_prs"
+	goalCISuccessRate      = "ci_success_rate"
+	goalMergedPRsPerWeek   = "merged_prs_per_week"
+)
+
+// MeasureMetric computes the current value of a goal or alert metric. With a team ("org/slug"), PR
+// metrics only count PRs authored by team members and issue metrics only issues assigned to them.
+// It reports false when there is no data to measure, e.g. no PRs merged in the window.
+func (g *GithubClient) MeasureMetric(owner, repo, team, metric string, days int32, filter *AuthorFilter) (float64, bool, error) {
+	if days <= 0 {
+		days = defaultWindowDays
+	}
+	since := windowStart(days)
+
+	members, err := g.teamMembers(team)
+	if err != nil {
+		return 0, false, err
+	}
+	counts := func(login, userType string) bool {
+		return !filter.Excludes(login, userType) && (members == nil || members[login])
+	}
+
+	switch metric {
+	case goalMergeTimeP50Hours, goalMergeTimeP90Hours, goalMergedPRsPerWeek:
+		prs, err := g.listPullRequests(owner, repo, "closed", since)
+		if err != nil {
+			return 0, false, fmt.Errorf("failed to fetch PRs: %w", err)
+		}
+		var hours []float64
+		for _, pr := range prs {
+			if pr.MergedAt == nil || pr.MergedAt.Time.Before(since) || !counts(pr.GetUser().GetLogin(), pr.GetUser().GetType()) {
+				continue
+			}
+			hours = append(hours, pr.MergedAt.Time.Sub(pr.GetCreatedAt().Time).Hours())
+		}
+		switch metric {
+		case goalMergedPRsPerWeek:
+			return float64(len(hours)) / (float64(days) / 7), true, nil
+		case goalMergeTimeP90Hours:
+			return stats.Percentile(hours, 90), len(hours) > 0, nil
+		}
+		return stats.Median(hours), len(hours) > 0, nil
+
+	case goalMaxReviewWaitHours, goalOpenPRs:
+		prs, err := g.listPullRequests(owner, repo, "open", time.Time{})
+		if err != nil {
+			return 0, false, fmt.Errorf("failed to fetch open PRs: %w", err)
+		}
+		var open int
+		var maxWait time.Duration
+		for _, pr := range prs {
+			if !counts(pr.GetUser().GetLogin(), pr.GetUser().GetType()) {
+				continue
+			}
+			open++
+			if metric != goalMaxReviewWaitHours || pr.GetDraft() {
+				continue
+			}
+			wait, err := g.waitingOnReview(owner, repo, pr)
+			if err != nil {
+				return 0, false, err
+			}
+			if wait > maxWait {
+				maxWait = wait
+			}
+		}
+		if metric == goalOpenPRs {
+			return float64(open), true, nil
+		}
+		return maxWait.Hours(), true, nil
+
+	case goalOpenIssues:
+		issues, err := g.listIssues(owner, repo, "open", time.Time{})
+		if err != nil {
+			return 0, false, fmt.Errorf("failed to fetch open issues: %w", err)
+		}
+		var open int
+		for _, issue := range issues {
+			if filter.Excludes(issue.GetUser().GetLogin(), issue.GetUser().GetType()) {
+				continue
+			}
+			if members != nil {
+				assigned := false
+				for _, assignee := range issue.Assignees {
+					assigned = assigned || members[assignee.GetLogin()]
+				}
+				if !assigned {
+					continue
+				}
+			}
+			open++
+		}
+		return float64(open), true, nil
+
+	case goalCISuccessRate:
+		ci, err := g.GetCISummary(owner, repo, since, time.Time{})
+		if err != nil {
+			return 0, false, err
+		}
+		return float64(ci.SuccessRate), ci.Runs > 0, nil
+	}
+
+	return 0, false, fmt.Errorf("unsupported goal metric %q", metric)
+}
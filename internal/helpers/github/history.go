@@ -0,0 +1,102 @@
+package github
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/go-github/v50/github"
+	"luminex-service/internal/helpers/codeowners"
+)
+
+const maxHistoryCommits = 300
+
+type fileChange struct {
+	path    string
+	author  string
+	lines   int
+	changed time.Time
+}
+
+// commitFileChanges returns the files touched by each commit since the given time. Only the most
+// recent maxHistoryCommits commits are inspected; it also returns how many commits were analyzed
+// and whether history was cut.
+func (g *GithubClient) commitFileChanges(owner, repo string, since time.Time, filter *AuthorFilter) ([]fileChange, int, bool, error) {
+	// One commit past the cap tells whether history was cut.
+	commits, err := g.listCommits(owner, repo, since, maxHistoryCommits+1)
+	if err != nil {
+		return nil, 0, false, fmt.Errorf("failed to fetch commits: %w", err)
+	}
+	truncated := len(commits) > maxHistoryCommits
+	if truncated {
+		commits = commits[:maxHistoryCommits]
+	}
+
+	var changes []fileChange
+	analyzed := 0
+	for _, c := range commits {
+		login := c.GetAuthor().GetLogin()
+		if login == "" || filter.Excludes(login, c.GetAuthor().GetType()) || len(c.Parents) > 1 {
+			continue
+		}
+		commit, _, err := g.client.Repositories.GetCommit(g.ctx, owner, repo, c.GetSHA(), nil)
+		if err != nil {
+			return nil, 0, false, fmt.Errorf("failed to fetch commit %s: %w", shortSHA(c.GetSHA()), err)
+		}
+		analyzed++
+		for _, file := range commit.Files {
+			lines := file.GetAdditions() + file.GetDeletions()
+			if lines == 0 {
+				lines = 1
+			}
+			changes = append(changes, fileChange{
+				path:    file.GetFilename(),
+				author:  login,
+				lines:   lines,
+				changed: commit.GetCommit().GetAuthor().GetDate().Time,
+			})
+		}
+	}
+	return changes, analyzed, truncated, nil
+}
+
+// fetchCodeOwners reads the CODEOWNERS file from its first known location. It returns nil
+// when the repository has none.
+func (g *GithubClient) fetchCodeOwners(owner, repo string) (*codeowners.File, string, error) {
+	for _, location := range codeowners.Locations {
+		content, _, _, err := g.client.Repositories.GetContents(g.ctx, owner, repo, location, nil)
+		var errResp *github.ErrorResponse
+		if errors.As(err, &errResp) && errResp.Response.StatusCode == http.StatusNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to fetch %s: %w", location, err)
+		}
+		text, err := content.GetContent()
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to decode %s: %w", location, err)
+		}
+		file, err := codeowners.Parse(text)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to parse %s: %w", location, err)
+		}
+		return file, location, nil
+	}
+	return nil, "", nil
+}
+
+// lastCommitAt returns when login last authored a commit on the default branch.
+func (g *GithubClient) lastCommitAt(owner, repo, login string) (time.Time, error) {
+	commits, _, err := g.client.Repositories.ListCommits(g.ctx, owner, repo, &github.CommitsListOptions{
+		Author:      login,
+		ListOptions: github.ListOptions{PerPage: 1},
+	})
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to fetch commits by %s: %w", login, err)
+	}
+	if len(commits) == 0 {
+		return time.Time{}, nil
+	}
+	return commits[0].GetCommit().GetAuthor().GetDate().Time, nil
+}
@@ -0,0 +1,143 @@
+package github
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/bikash-789/comm-protos/luminex/v1/request"
+	"github.com/bikash-789/comm-protos/luminex/v1/response"
+	"github.com/google/go-github/v50/github"
+	"luminex-service/internal/conf"
+	"luminex-service/internal/helpers/pathmatch"
+)
+
+const (
+	defaultHotspotLimit = 25
+	defaultBugLabel     = "bug"
+)
+
+type churn struct {
+	stats   *response.Hotspot
+	authors map[string]bool
+}
+
+func (c *churn) add(author string, lines int, bugFix bool) {
+	c.stats.Changes++
+	c.stats.LinesChanged += int32(lines)
+	if bugFix {
+		c.stats.BugFixes++
+	}
+	c.authors[author] = true
+}
+
+// isBugFix matches the PR labels against bugLabels case-insensitively. Without configured labels,
+// any label containing "bug" counts.
+func isBugFix(pr *github.PullRequest, bugLabels []string) bool {
+	for _, label := range pr.Labels {
+		name := strings.ToLower(label.GetName())
+		if len(bugLabels) == 0 && strings.Contains(name, defaultBugLabel) {
+			return true
+		}
+		for _, bugLabel := range bugLabels {
+			if name == strings.ToLower(bugLabel) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// rankHotspots scores each entry by how often it changes, weighted by the bug fixes touching it,
+// and returns the top limit entries.
+func rankHotspots(entries map[string]*churn, limit int) []*response.Hotspot {
+	result := make([]*response.Hotspot, 0, len(entries))
+	for _, c := range entries {
+		c.stats.Authors = int32(len(c.authors))
+		c.stats.Score = float32(c.stats.Changes) * float32(1+c.stats.BugFixes)
+		result = append(result, c.stats)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		a, b := result[i], result[j]
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		if a.LinesChanged != b.LinesChanged {
+			return a.LinesChanged > b.LinesChanged
+		}
+		return a.Path < b.Path
+	})
+	if len(result) > limit {
+		result = result[:limit]
+	}
+	return result
+}
+
+func (g *GithubClient) GetHotspots(req *request.HotspotsRequest, sizeSettings *conf.PRSizeSettings, filter *AuthorFilter) (*response.HotspotsResponse, error) {
+	owner := req.Owner
+	repo := req.Repo
+	since := windowStart(req.Days)
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultHotspotLimit
+	}
+	depth := int(req.DirectoryDepth)
+	if depth <= 0 {
+		depth = defaultDirectoryDepth
+	}
+	excluded, err := pathmatch.CompileAll(sizeSettings.ExcludeGlobs)
+	if err != nil {
+		return nil, fmt.Errorf("invalid exclude glob: %w", err)
+	}
+
+	prs, err := g.listPullRequests(owner, repo, "closed", since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch PRs: %w", err)
+	}
+
+	files := make(map[string]*churn)
+	directories := make(map[string]*churn)
+	entry := func(entries map[string]*churn, path string) *churn {
+		if entries[path] == nil {
+			entries[path] = &churn{stats: &response.Hotspot{Path: path}, authors: make(map[string]bool)}
+		}
+		return entries[path]
+	}
+
+	result := &response.HotspotsResponse{}
+	for _, pr := range prs {
+		if pr.MergedAt == nil || pr.MergedAt.Time.Before(since) {
+			continue
+		}
+		author := pr.GetUser().GetLogin()
+		if filter.Excludes(author, pr.GetUser().GetType()) {
+			continue
+		}
+		bugFix := isBugFix(pr, req.BugLabels)
+		result.PrsAnalyzed++
+		if bugFix {
+			result.BugFixPrs++
+		}
+
+		prFiles, err := g.listPRFiles(owner, repo, pr.GetNumber())
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch files for PR #%d: %w", pr.GetNumber(), err)
+		}
+		touched := make(map[string]int)
+		for _, file := range prFiles {
+			if pathmatch.MatchAny(excluded, file.GetFilename()) {
+				continue
+			}
+			lines := file.GetAdditions() + file.GetDeletions()
+			entry(files, file.GetFilename()).add(author, lines, bugFix)
+			touched[directoryAt(file.GetFilename(), depth)] += lines
+		}
+		for dir, lines := range touched {
+			entry(directories, dir).add(author, lines, bugFix)
+		}
+	}
+
+	result.Files = rankHotspots(files, limit)
+	result.Directories = rankHotspots(directories, limit)
+	return result, nil
+}
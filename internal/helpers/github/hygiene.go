@@ -0,0 +1,157 @@
+package github
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/bikash-789/comm-protos/luminex/v1/request"
+	"github.com/bikash-789/comm-protos/luminex/v1/response"
+	"github.com/google/go-github/v50/github"
+	"luminex-service/internal/conf"
+	"luminex-service/internal/helpers/conventional"
+)
+
+const (
+	ruleConventionalTitle = "conventional_title"
+	ruleLinkedIssue       = "linked_issue"
+	ruleDescription       = "description"
+	ruleLabels            = "labels"
+	ruleReviewed          = "reviewed"
+	ruleNoForcePush       = "no_force_push_after_approval"
+)
+
+// linkedIssuePattern matches GitHub closing keywords followed by an issue reference, or an issue URL.
+var linkedIssuePattern = regexp.MustCompile(`(?i)\b(?:close[sd]?|fix(?:e[sd])?|resolve[sd]?):?\s+(?:[\w.-]+/[\w.-]+)?#\d+|https://github\.com/[\w.-]+/[\w.-]+/issues/\d+`)
+
+type prHygiene struct {
+	linkedIssue          bool
+	reviewed             bool
+	forcePushAfterReview bool
+}
+
+// inspectTimeline reads issue links, approvals before merge and force-pushes after the first approval.
+func inspectTimeline(pr *github.PullRequest, events []*github.Timeline) *prHygiene {
+	hygiene := &prHygiene{}
+	author := pr.GetUser().GetLogin()
+	approved := false
+	for _, event := range events {
+		switch event.GetEvent() {
+		case "connected":
+			hygiene.linkedIssue = true
+		case "reviewed":
+			if event.GetUser().GetLogin() == author || event.SubmittedAt == nil {
+				continue
+			}
+			if strings.EqualFold(event.GetState(), "approved") && event.SubmittedAt.Time.Before(pr.GetMergedAt().Time) {
+				hygiene.reviewed = true
+				approved = true
+			}
+		case "head_ref_force_pushed":
+			if approved {
+				hygiene.forcePushAfterReview = true
+			}
+		}
+	}
+	return hygiene
+}
+
+func isHygieneRule(rule string) bool {
+	for _, name := range conf.HygieneRuleNames {
+		if name == rule {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *GithubClient) GetHygieneReport(req *request.HygieneReportRequest, rules *conf.HygieneRules, filter *AuthorFilter) (*response.HygieneReportResponse, error) {
+	owner := req.Owner
+	repo := req.Repo
+	since := windowStart(req.Days)
+
+	results := make(map[string]*response.HygieneRuleResult)
+	enabled := make([]string, 0, len(rules.Rules))
+	for _, rule := range rules.Rules {
+		if !isHygieneRule(rule) {
+			return nil, fmt.Errorf("unknown hygiene rule %q, expected one of %s", rule, strings.Join(conf.HygieneRuleNames, ", "))
+		}
+		if results[rule] == nil {
+			results[rule] = &response.HygieneRuleResult{Rule: rule}
+			enabled = append(enabled, rule)
+		}
+	}
+
+	prs, err := g.listPullRequests(owner, repo, "closed", since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch PRs: %w", err)
+	}
+
+	result := &response.HygieneReportResponse{}
+	for _, pr := range prs {
+		if pr.MergedAt == nil || pr.MergedAt.Time.Before(since) {
+			continue
+		}
+		if filter.Excludes(pr.GetUser().GetLogin(), pr.GetUser().GetType()) {
+			continue
+		}
+		result.MergedPrs++
+
+		events, err := g.listTimeline(owner, repo, pr.GetNumber())
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch timeline for PR #%d: %w", pr.GetNumber(), err)
+		}
+		hygiene := inspectTimeline(pr, events)
+
+		var failed []string
+		for _, rule := range enabled {
+			var passed bool
+			switch rule {
+			case ruleConventionalTitle:
+				passed = conventional.Valid(pr.GetTitle(), rules.ConventionalTypes)
+			case ruleLinkedIssue:
+				passed = hygiene.linkedIssue || linkedIssuePattern.MatchString(pr.GetBody())
+			case ruleDescription:
+				passed = strings.TrimSpace(pr.GetBody()) != ""
+			case ruleLabels:
+				passed = len(pr.Labels) > 0
+			case ruleReviewed:
+				passed = hygiene.reviewed
+			case ruleNoForcePush:
+				passed = !hygiene.forcePushAfterReview
+			}
+			if passed {
+				results[rule].Passed++
+			} else {
+				results[rule].Failed++
+				failed = append(failed, rule)
+			}
+		}
+
+		if len(failed) == 0 {
+			result.CompliantPrs++
+			continue
+		}
+		result.Violations = append(result.Violations, &response.HygieneViolation{
+			Number:      int32(pr.GetNumber()),
+			Title:       pr.GetTitle(),
+			Author:      pr.GetUser().GetLogin(),
+			Url:         pr.GetHTMLURL(),
+			MergedAt:    pr.MergedAt.Format("2006-01-02T15:04:05Z"),
+			FailedRules: failed,
+		})
+	}
+
+	for _, rule := range enabled {
+		r := results[rule]
+		if total := r.Passed + r.Failed; total > 0 {
+			r.PassRate = float32(r.Passed) / float32(total) * 100
+		}
+		result.Rules = append(result.Rules, r)
+	}
+	if result.MergedPrs > 0 {
+		result.ComplianceRate = float32(result.CompliantPrs) / float32(result.MergedPrs) * 100
+	}
+
+	return result, nil
+}
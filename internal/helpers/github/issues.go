@@ -0,0 +1,238 @@
+package github
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/bikash-789/comm-protos/luminex/v1/response"
+	"github.com/google/go-github/v50/github"
+)
+
+const (
+	backlogWeeks    = 12
+	unassignedIssue = "unassigned"
+	unlabelledIssue = "unlabelled"
+)
+
+var issueAgeBuckets = []struct {
+	label   string
+	maxDays float64
+}{
+	{"0-7d", 7},
+	{"7-30d", 30},
+	{"30-90d", 90},
+	{"90d+", -1},
+}
+
+var maintainerAssociations = map[string]bool{
+	"OWNER":        true,
+	"MEMBER":       true,
+	"COLLABORATOR": true,
+}
+
+// addBacklogHealth extends the issue stats with aging, weekly flow, maintainer response
+// times and label/assignee breakdowns.
+func (g *GithubClient) addBacklogHealth(owner, repo string, filter *AuthorFilter, result *response.IssueStatsResponse) error {
+	now := time.Now()
+	openIssues, err := g.listIssues(owner, repo, "open", time.Time{})
+	if err != nil {
+		return fmt.Errorf("failed to fetch open issues: %w", err)
+	}
+
+	weekStart := startOfWeek(now).AddDate(0, 0, -7*(backlogWeeks-1))
+	recentIssues, err := g.listIssues(owner, repo, "all", weekStart)
+	if err != nil {
+		return fmt.Errorf("failed to fetch recent issues: %w", err)
+	}
+
+	ageCounts := make([]int32, len(issueAgeBuckets))
+	labels := make(map[string]*issueBreakdown)
+	assignees := make(map[string]*issueBreakdown)
+	for _, issue := range openIssues {
+		if filter.Excludes(issue.GetUser().GetLogin(), issue.GetUser().GetType()) {
+			continue
+		}
+		age := now.Sub(issue.GetCreatedAt().Time)
+		for i, bucket := range issueAgeBuckets {
+			if bucket.maxDays < 0 || age.Hours()/24 < bucket.maxDays {
+				ageCounts[i]++
+				break
+			}
+		}
+		for _, label := range issueLabels(issue) {
+			breakdown(labels, label).addOpen(age)
+		}
+		for _, assignee := range issueAssignees(issue) {
+			breakdown(assignees, assignee).addOpen(age)
+		}
+	}
+	for i, bucket := range issueAgeBuckets {
+		result.AgingBuckets = append(result.AgingBuckets, &response.IssueAgeBucket{
+			Range: bucket.label,
+			Count: ageCounts[i],
+		})
+	}
+
+	weeks := make([]*response.WeeklyIssueFlow, backlogWeeks)
+	for i := range weeks {
+		weeks[i] = &response.WeeklyIssueFlow{WeekStart: weekStart.AddDate(0, 0, 7*i).Format("2006-01-02")}
+	}
+	weekIndex := func(t time.Time) int {
+		if t.Before(weekStart) {
+			return -1
+		}
+		return int(t.Sub(weekStart).Hours() / (24 * 7))
+	}
+
+	created := make(map[int]*github.Issue)
+	for _, issue := range recentIssues {
+		if filter.Excludes(issue.GetUser().GetLogin(), issue.GetUser().GetType()) {
+			continue
+		}
+		if i := weekIndex(issue.GetCreatedAt().Time); i >= 0 && i < backlogWeeks {
+			weeks[i].Opened++
+			created[issue.GetNumber()] = issue
+		}
+		if issue.ClosedAt != nil {
+			if i := weekIndex(issue.ClosedAt.Time); i >= 0 && i < backlogWeeks {
+				weeks[i].Closed++
+				for _, label := range issueLabels(issue) {
+					breakdown(labels, label).Closed++
+				}
+				for _, assignee := range issueAssignees(issue) {
+					breakdown(assignees, assignee).Closed++
+				}
+			}
+		}
+	}
+	var net int32
+	for _, week := range weeks {
+		week.Net = week.Opened - week.Closed
+		net += week.Net
+	}
+	result.WeeklyFlow = weeks
+	result.BacklogGrowthPerWeek = float32(net) / float32(backlogWeeks)
+
+	responseTimes, err := g.maintainerResponseTimes(owner, repo, weekStart, created)
+	if err != nil {
+		return err
+	}
+	result.IssuesWithMaintainerResponse = int32(len(responseTimes))
+	result.MedianFirstResponseTime = medianDuration(responseTimes)
+	var totalResponseTime time.Duration
+	for _, d := range responseTimes {
+		totalResponseTime += d
+	}
+	result.AvgFirstResponseTime = averageDuration(totalResponseTime, len(responseTimes))
+
+	result.ByLabel = sortedBreakdowns(labels)
+	result.ByAssignee = sortedBreakdowns(assignees)
+	return nil
+}
+
+// maintainerResponseTimes returns, for each of the given issues, the delay until the first
+// comment by an owner, member or collaborator other than the issue author.
+func (g *GithubClient) maintainerResponseTimes(owner, repo string, since time.Time, issues map[int]*github.Issue) ([]time.Duration, error) {
+	sortBy, direction := "created", "asc"
+	opts := &github.IssueListCommentsOptions{
+		Sort:        &sortBy,
+		Direction:   &direction,
+		Since:       &since,
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+
+	firstResponse := make(map[int]time.Duration)
+	for {
+		comments, resp, err := g.client.Issues.ListComments(g.ctx, owner, repo, 0, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch issue comments: %w", err)
+		}
+		for _, comment := range comments {
+			number := numberFromURL(comment.GetIssueURL())
+			issue, ok := issues[number]
+			if !ok || !maintainerAssociations[comment.GetAuthorAssociation()] {
+				continue
+			}
+			if comment.GetUser().GetLogin() == issue.GetUser().GetLogin() {
+				continue
+			}
+			delay := comment.GetCreatedAt().Time.Sub(issue.GetCreatedAt().Time)
+			if current, seen := firstResponse[number]; !seen || delay < current {
+				firstResponse[number] = delay
+			}
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	result := make([]time.Duration, 0, len(firstResponse))
+	for _, d := range firstResponse {
+		result = append(result, d)
+	}
+	return result, nil
+}
+
+type issueBreakdown struct {
+	*response.IssueBreakdown
+	totalOpenAge time.Duration
+}
+
+func (b *issueBreakdown) addOpen(age time.Duration) {
+	b.Open++
+	b.totalOpenAge += age
+}
+
+func issueLabels(issue *github.Issue) []string {
+	if len(issue.Labels) == 0 {
+		return []string{unlabelledIssue}
+	}
+	labels := make([]string, 0, len(issue.Labels))
+	for _, label := range issue.Labels {
+		labels = append(labels, label.GetName())
+	}
+	return labels
+}
+
+func issueAssignees(issue *github.Issue) []string {
+	if len(issue.Assignees) == 0 {
+		return []string{unassignedIssue}
+	}
+	assignees := make([]string, 0, len(issue.Assignees))
+	for _, assignee := range issue.Assignees {
+		assignees = append(assignees, assignee.GetLogin())
+	}
+	return assignees
+}
+
+func breakdown(breakdowns map[string]*issueBreakdown, name string) *issueBreakdown {
+	if breakdowns[name] == nil {
+		breakdowns[name] = &issueBreakdown{IssueBreakdown: &response.IssueBreakdown{Name: name}}
+	}
+	return breakdowns[name]
+}
+
+func sortedBreakdowns(breakdowns map[string]*issueBreakdown) []*response.IssueBreakdown {
+	result := make([]*response.IssueBreakdown, 0, len(breakdowns))
+	for _, b := range breakdowns {
+		if b.Open > 0 {
+			b.AvgOpenAgeDays = float32(b.totalOpenAge.Hours() / 24 / float64(b.Open))
+		}
+		result = append(result, b.IssueBreakdown)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Open != result[j].Open {
+			return result[i].Open > result[j].Open
+		}
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
+
+func startOfWeek(t time.Time) time.Time {
+	offset := (int(t.Weekday()) + 6) % 7
+	year, month, day := t.AddDate(0, 0, -offset).Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+}
@@ -0,0 +1,251 @@
+package github
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bikash-789/comm-protos/luminex/v1/request"
+	"github.com/bikash-789/comm-protos/luminex/v1/response"
+	"github.com/google/go-github/v50/github"
+)
+
+const (
+	groupByLabel     = "label"
+	groupByPrefix    = "prefix"
+	groupByMilestone = "milestone"
+
+	noMilestone         = "no milestone"
+	velocityWeeks       = 4
+	milestoneDateLayout = "2006-01-02"
+)
+
+type workGroup struct {
+	group          *response.WorkGroup
+	resolutionTime time.Duration
+	resolved       int
+	mergeTime      time.Duration
+	merged         int
+}
+
+func labelPrefix(label string) string {
+	if i := strings.IndexAny(label, "/:"); i > 0 {
+		return label[:i]
+	}
+	return ""
+}
+
+// workGroupKeys maps an issue or PR to the groups it counts towards for the requested grouping.
+func workGroupKeys(groupBy, labelFilter string, labels []*github.Label, milestone *github.Milestone) []string {
+	if groupBy == groupByMilestone {
+		if milestone == nil {
+			return []string{noMilestone}
+		}
+		return []string{milestone.GetTitle()}
+	}
+
+	keys := make([]string, 0, len(labels))
+	seen := make(map[string]bool)
+	for _, label := range labels {
+		name := label.GetName()
+		if labelFilter != "" && !strings.HasPrefix(name, labelFilter) {
+			continue
+		}
+		key := name
+		if groupBy == groupByPrefix {
+			key = labelPrefix(name)
+		}
+		if key != "" && !seen[key] {
+			seen[key] = true
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+func (g *GithubClient) GetLabelBreakdown(req *request.LabelBreakdownRequest, filter *AuthorFilter) (*response.LabelBreakdownResponse, error) {
+	owner := req.Owner
+	repo := req.Repo
+	since := windowStart(req.Days)
+	groupBy := req.GroupBy
+	if groupBy == "" {
+		groupBy = groupByLabel
+	}
+	if groupBy != groupByLabel && groupBy != groupByPrefix && groupBy != groupByMilestone {
+		return nil, fmt.Errorf("unsupported group_by %q, expected %s, %s or %s", groupBy, groupByLabel, groupByPrefix, groupByMilestone)
+	}
+
+	groups := make(map[string]*workGroup)
+	group := func(name string) *workGroup {
+		if groups[name] == nil {
+			groups[name] = &workGroup{group: &response.WorkGroup{Name: name}}
+		}
+		return groups[name]
+	}
+
+	openIssues, err := g.listIssues(owner, repo, "open", time.Time{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch open issues: %w", err)
+	}
+	closedIssues, err := g.listIssues(owner, repo, "closed", since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch closed issues: %w", err)
+	}
+	for _, issue := range append(openIssues, closedIssues...) {
+		if filter.Excludes(issue.GetUser().GetLogin(), issue.GetUser().GetType()) {
+			continue
+		}
+		for _, key := range workGroupKeys(groupBy, req.LabelPrefix, issue.Labels, issue.Milestone) {
+			w := group(key)
+			if issue.GetState() == "open" {
+				w.group.OpenIssues++
+			}
+			if issue.GetCreatedAt().Time.After(since) {
+				w.group.IssuesOpened++
+			}
+			if issue.ClosedAt != nil && issue.ClosedAt.Time.After(since) {
+				w.group.IssuesClosed++
+				w.resolutionTime += issue.ClosedAt.Time.Sub(issue.GetCreatedAt().Time)
+				w.resolved++
+			}
+		}
+	}
+
+	openPRs, err := g.listPullRequests(owner, repo, "open", time.Time{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch open PRs: %w", err)
+	}
+	closedPRs, err := g.listPullRequests(owner, repo, "closed", since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch closed PRs: %w", err)
+	}
+	for _, pr := range append(openPRs, closedPRs...) {
+		if filter.Excludes(pr.GetUser().GetLogin(), pr.GetUser().GetType()) {
+			continue
+		}
+		for _, key := range workGroupKeys(groupBy, req.LabelPrefix, pr.Labels, pr.Milestone) {
+			w := group(key)
+			if pr.GetState() == "open" {
+				w.group.OpenPrs++
+			}
+			if pr.GetCreatedAt().Time.After(since) {
+				w.group.PrsOpened++
+			}
+			if pr.MergedAt != nil && pr.MergedAt.Time.After(since) {
+				w.group.PrsMerged++
+				w.mergeTime += pr.MergedAt.Time.Sub(pr.GetCreatedAt().Time)
+				w.merged++
+			}
+		}
+	}
+
+	result := &response.LabelBreakdownResponse{
+		GroupBy: groupBy,
+		Groups:  make([]*response.WorkGroup, 0, len(groups)),
+	}
+	for _, w := range groups {
+		w.group.AvgIssueResolutionTime = averageDuration(w.resolutionTime, w.resolved)
+		w.group.AvgPrMergeTime = averageDuration(w.mergeTime, w.merged)
+		result.Groups = append(result.Groups, w.group)
+	}
+	sort.Slice(result.Groups, func(i, j int) bool {
+		a, b := result.Groups[i], result.Groups[j]
+		if a.OpenIssues+a.OpenPrs != b.OpenIssues+b.OpenPrs {
+			return a.OpenIssues+a.OpenPrs > b.OpenIssues+b.OpenPrs
+		}
+		return a.Name < b.Name
+	})
+
+	return result, nil
+}
+
+// GetMilestoneProgress reports the progress of each milestone. Item counts come from the milestone
+// itself; the closing velocity leaves out items opened by excluded authors.
+func (g *GithubClient) GetMilestoneProgress(req *request.MilestoneProgressRequest, filter *AuthorFilter) (*response.MilestoneProgressResponse, error) {
+	owner := req.Owner
+	repo := req.Repo
+	state := req.State
+	if state == "" {
+		state = "open"
+	}
+
+	milestones, err := g.listMilestones(owner, repo, state)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch milestones: %w", err)
+	}
+
+	now := time.Now()
+	velocitySince := now.AddDate(0, 0, -7*velocityWeeks)
+	result := &response.MilestoneProgressResponse{
+		Milestones: make([]*response.MilestoneProgress, 0, len(milestones)),
+	}
+
+	for _, milestone := range milestones {
+		progress := &response.MilestoneProgress{
+			Number:      int32(milestone.GetNumber()),
+			Title:       milestone.GetTitle(),
+			State:       milestone.GetState(),
+			Url:         milestone.GetHTMLURL(),
+			OpenItems:   int32(milestone.GetOpenIssues()),
+			ClosedItems: int32(milestone.GetClosedIssues()),
+		}
+		if milestone.DueOn != nil {
+			progress.DueOn = milestone.DueOn.Format(milestoneDateLayout)
+		}
+		if total := progress.OpenItems + progress.ClosedItems; total > 0 {
+			progress.PercentComplete = float32(progress.ClosedItems) / float32(total) * 100
+		}
+
+		closedRecently, err := g.milestoneClosedSince(owner, repo, milestone.GetNumber(), velocitySince, filter)
+		if err != nil {
+			return nil, err
+		}
+		progress.ClosingVelocityPerWeek = float32(closedRecently) / velocityWeeks
+
+		switch {
+		case progress.OpenItems == 0:
+			progress.ProjectedCompletion = now.Format(milestoneDateLayout)
+			progress.OnTrack = true
+		case progress.ClosingVelocityPerWeek > 0:
+			weeksLeft := float64(progress.OpenItems) / float64(progress.ClosingVelocityPerWeek)
+			projected := now.Add(time.Duration(math.Ceil(weeksLeft*7*24)) * time.Hour)
+			progress.ProjectedCompletion = projected.Format(milestoneDateLayout)
+			progress.OnTrack = milestone.DueOn == nil || !projected.After(milestone.DueOn.Time)
+		default:
+			progress.ProjectedCompletion = "N/A"
+		}
+
+		result.Milestones = append(result.Milestones, progress)
+	}
+
+	return result, nil
+}
+
+// milestoneClosedSince counts issues and PRs of the milestone closed after since.
+func (g *GithubClient) milestoneClosedSince(owner, repo string, milestone int, since time.Time, filter *AuthorFilter) (int, error) {
+	opts := &github.IssueListByRepoOptions{
+		Milestone:   fmt.Sprint(milestone),
+		State:       "closed",
+		Since:       since,
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+
+	closed := 0
+	for {
+		items, resp, err := g.client.Issues.ListByRepo(g.ctx, owner, repo, opts)
+		if err != nil {
+			return 0, fmt.Errorf("failed to fetch items of milestone %d: %w", milestone, err)
+		}
+		for _, item := range items {
+			if item.ClosedAt != nil && item.ClosedAt.Time.After(since) && !filter.Excludes(item.GetUser().GetLogin(), item.GetUser().GetType()) {
+				closed++
+			}
+		}
+		if resp.NextPage == 0 {
+			return closed, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
@@ -0,0 +1,74 @@
+package github
+
+import (
+	"time"
+
+	"github.com/google/go-github/v50/github"
+)
+
+// listWorkflowRuns lists the runs created on or after the day of since and, unless until is zero,
+// on or before the day of until.
+func (g *GithubClient) listWorkflowRuns(owner, repo string, since, until time.Time) ([]*github.WorkflowRun, error) {
+	created := ">=" + since.Format("2006-01-02")
+	if !until.IsZero() {
+		created = since.Format("2006-01-02") + ".." + until.Format("2006-01-02")
+	}
+	opts := &github.ListWorkflowRunsOptions{
+		Created:     created,
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+
+	var result []*github.WorkflowRun
+	for {
+		runs, resp, err := g.client.Actions.ListRepositoryWorkflowRuns(g.ctx, owner, repo, opts)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, runs.WorkflowRuns...)
+		if resp.NextPage == 0 {
+			return result, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+func (g *GithubClient) listWorkflowJobs(owner, repo string, runID int64) ([]*github.WorkflowJob, error) {
+	opts := &github.ListWorkflowJobsOptions{
+		Filter:      "all",
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+
+	var result []*github.WorkflowJob
+	for {
+		jobs, resp, err := g.client.Actions.ListWorkflowJobs(g.ctx, owner, repo, runID, opts)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, jobs.Jobs...)
+		if resp.NextPage == 0 {
+			return result, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// listCheckRuns lists every check run reported for ref, including earlier attempts.
+func (g *GithubClient) listCheckRuns(owner, repo, ref string) ([]*github.CheckRun, error) {
+	opts := &github.ListCheckRunsOptions{
+		Filter:      github.String("all"),
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+
+	var result []*github.CheckRun
+	for {
+		runs, resp, err := g.client.Checks.ListCheckRunsForRef(g.ctx, owner, repo, ref, opts)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, runs.CheckRuns...)
+		if resp.NextPage == 0 {
+			return result, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
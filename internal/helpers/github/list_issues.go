@@ -0,0 +1,114 @@
+package github
+
+import (
+	"time"
+
+	"github.com/google/go-github/v50/github"
+)
+
+func (g *GithubClient) listIssues(owner, repo, state string, since time.Time) ([]*github.Issue, error) {
+	opts := &github.IssueListByRepoOptions{
+		State:       state,
+		Since:       since,
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+
+	var result []*github.Issue
+	for {
+		issues, resp, err := g.client.Issues.ListByRepo(g.ctx, owner, repo, opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, issue := range issues {
+			if issue.PullRequestLinks != nil {
+				continue
+			}
+			result = append(result, issue)
+		}
+		if resp.NextPage == 0 {
+			return result, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// listRepoIssueComments lists the conversation comments on all issues and PRs updated since the given time.
+func (g *GithubClient) listRepoIssueComments(owner, repo string, since time.Time) ([]*github.IssueComment, error) {
+	opts := &github.IssueListCommentsOptions{
+		Since:       &since,
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+
+	var result []*github.IssueComment
+	for {
+		comments, resp, err := g.client.Issues.ListComments(g.ctx, owner, repo, 0, opts)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, comments...)
+		if resp.NextPage == 0 {
+			return result, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+func (g *GithubClient) listTimeline(owner, repo string, number int) ([]*github.Timeline, error) {
+	opts := &github.ListOptions{PerPage: 100}
+
+	var result []*github.Timeline
+	for {
+		events, resp, err := g.client.Issues.ListIssueTimeline(g.ctx, owner, repo, number, opts)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, events...)
+		if resp.NextPage == 0 {
+			return result, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+func (g *GithubClient) listMilestones(owner, repo, state string) ([]*github.Milestone, error) {
+	opts := &github.MilestoneListOptions{
+		State:       state,
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+
+	var result []*github.Milestone
+	for {
+		milestones, resp, err := g.client.Issues.ListMilestones(g.ctx, owner, repo, opts)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, milestones...)
+		if resp.NextPage == 0 {
+			return result, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// listIssueEvents returns the repository's issue and PR events created after since, newest first.
+func (g *GithubClient) listIssueEvents(owner, repo string, since time.Time) ([]*github.IssueEvent, error) {
+	opts := &github.ListOptions{PerPage: 100}
+
+	var result []*github.IssueEvent
+	for {
+		events, resp, err := g.client.Issues.ListRepositoryEvents(g.ctx, owner, repo, opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, event := range events {
+			if event.CreatedAt != nil && event.CreatedAt.Time.Before(since) {
+				return result, nil
+			}
+			result = append(result, event)
+		}
+		if resp.NextPage == 0 {
+			return result, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
@@ -0,0 +1,89 @@
+package github
+
+import (
+	"time"
+
+	"github.com/google/go-github/v50/github"
+)
+
+func (g *GithubClient) listPullRequests(owner, repo, state string, since time.Time) ([]*github.PullRequest, error) {
+	opts := &github.PullRequestListOptions{
+		State:       state,
+		Sort:        "updated",
+		Direction:   "desc",
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+
+	var result []*github.PullRequest
+	for {
+		prs, resp, err := g.client.PullRequests.List(g.ctx, owner, repo, opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, pr := range prs {
+			if pr.UpdatedAt != nil && pr.UpdatedAt.Time.Before(since) {
+				return result, nil
+			}
+			result = append(result, pr)
+		}
+		if resp.NextPage == 0 {
+			return result, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+func (g *GithubClient) listReviews(owner, repo string, number int) ([]*github.PullRequestReview, error) {
+	opts := &github.ListOptions{PerPage: 100}
+
+	var result []*github.PullRequestReview
+	for {
+		reviews, resp, err := g.client.PullRequests.ListReviews(g.ctx, owner, repo, number, opts)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, reviews...)
+		if resp.NextPage == 0 {
+			return result, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+func (g *GithubClient) listPRFiles(owner, repo string, number int) ([]*github.CommitFile, error) {
+	opts := &github.ListOptions{PerPage: 100}
+
+	var result []*github.CommitFile
+	for {
+		files, resp, err := g.client.PullRequests.ListFiles(g.ctx, owner, repo, number, opts)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, files...)
+		if resp.NextPage == 0 {
+			return result, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// listRepoReviewComments lists the review comments on all PRs updated since the given time.
+func (g *GithubClient) listRepoReviewComments(owner, repo string, since time.Time) ([]*github.PullRequestComment, error) {
+	opts := &github.PullRequestListCommentsOptions{
+		Since:       since,
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+
+	var result []*github.PullRequestComment
+	for {
+		comments, resp, err := g.client.PullRequests.ListComments(g.ctx, owner, repo, 0, opts)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, comments...)
+		if resp.NextPage == 0 {
+			return result, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
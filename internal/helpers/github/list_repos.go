@@ -0,0 +1,135 @@
+package github
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/go-github/v50/github"
+)
+
+const (
+	contributorStatsAttempts   = 3
+	contributorStatsRetryDelay = 2 * time.Second
+)
+
+func (g *GithubClient) listReleases(owner, repo string, limit int) ([]*github.RepositoryRelease, error) {
+	opts := &github.ListOptions{PerPage: 100}
+
+	var result []*github.RepositoryRelease
+	for {
+		releases, resp, err := g.client.Repositories.ListReleases(g.ctx, owner, repo, opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, release := range releases {
+			if release.GetDraft() || release.PublishedAt == nil {
+				continue
+			}
+			result = append(result, release)
+			if len(result) == limit {
+				return result, nil
+			}
+		}
+		if resp.NextPage == 0 {
+			return result, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+func (g *GithubClient) listTags(owner, repo string) ([]*github.RepositoryTag, error) {
+	opts := &github.ListOptions{PerPage: 100}
+
+	var result []*github.RepositoryTag
+	for {
+		tags, resp, err := g.client.Repositories.ListTags(g.ctx, owner, repo, opts)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, tags...)
+		if resp.NextPage == 0 {
+			return result, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+func (g *GithubClient) compareCommits(owner, repo, base, head string) ([]*github.RepositoryCommit, error) {
+	opts := &github.ListOptions{PerPage: 100}
+
+	var result []*github.RepositoryCommit
+	for {
+		comparison, resp, err := g.client.Repositories.CompareCommits(g.ctx, owner, repo, base, head, opts)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, comparison.Commits...)
+		if resp.NextPage == 0 {
+			return result, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// listCommits lists the commits since the given time, newest first, stopping after limit commits
+// unless limit is 0.
+func (g *GithubClient) listCommits(owner, repo string, since time.Time, limit int) ([]*github.RepositoryCommit, error) {
+	opts := &github.CommitsListOptions{
+		Since:       since,
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+
+	var result []*github.RepositoryCommit
+	for {
+		commits, resp, err := g.client.Repositories.ListCommits(g.ctx, owner, repo, opts)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, commits...)
+		if limit > 0 && len(result) >= limit {
+			return result[:limit], nil
+		}
+		if resp.NextPage == 0 {
+			return result, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// listContributorWeeks returns weekly additions, deletions and commits per author. GitHub answers
+// 202 Accepted while it computes these statistics, so the request is retried a few times; if they
+// are still not ready it reports false instead of failing.
+func (g *GithubClient) listContributorWeeks(owner, repo string) ([]*github.ContributorStats, bool, error) {
+	for attempt := 1; ; attempt++ {
+		stats, _, err := g.client.Repositories.ListContributorsStats(g.ctx, owner, repo)
+		var accepted *github.AcceptedError
+		if errors.As(err, &accepted) {
+			if attempt < contributorStatsAttempts {
+				time.Sleep(contributorStatsRetryDelay)
+				continue
+			}
+			return nil, false, nil
+		}
+		if err != nil {
+			return nil, false, err
+		}
+		return stats, true, nil
+	}
+}
+
+func (g *GithubClient) listContributors(owner, repo string) ([]*github.Contributor, error) {
+	opts := &github.ListContributorsOptions{ListOptions: github.ListOptions{PerPage: 100}}
+
+	var result []*github.Contributor
+	for {
+		contributors, resp, err := g.client.Repositories.ListContributors(g.ctx, owner, repo, opts)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, contributors...)
+		if resp.NextPage == 0 {
+			return result, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
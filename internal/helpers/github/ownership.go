@@ -0,0 +1,206 @@
+package github
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bikash-789/comm-protos/luminex/v1/request"
+	"github.com/bikash-789/comm-protos/luminex/v1/response"
+	"luminex-service/internal/helpers/codeowners"
+	"luminex-service/internal/helpers/stats"
+)
+
+type ownerLatency struct {
+	stats     *response.OwnerReviewLatency
+	latencies []time.Duration
+	median    float64
+}
+
+// codeOwnerResolver expands CODEOWNERS entries into logins, resolving each team once. Owners that
+// cannot be mapped to accounts, i.e. emails and teams that are missing or not visible to the
+// token, match nobody and are recorded as unresolved.
+type codeOwnerResolver struct {
+	g          *GithubClient
+	teams      map[string]map[string]bool
+	unresolved map[string]bool
+}
+
+func (r *codeOwnerResolver) includes(owner, login string) (bool, error) {
+	switch {
+	case codeowners.IsUser(owner):
+		return strings.EqualFold(strings.TrimPrefix(owner, "@"), login), nil
+	case codeowners.IsTeam(owner):
+		if r.teams[owner] == nil && !r.unresolved[owner] {
+			org, slug := codeowners.TeamSlug(owner)
+			team, err := r.g.resolveTeam(org, slug)
+			if teamNotVisible(err) {
+				r.unresolved[owner] = true
+				return false, nil
+			}
+			if err != nil {
+				return false, err
+			}
+			r.teams[owner] = team.members
+		}
+		return r.teams[owner][login], nil
+	}
+	r.unresolved[owner] = true
+	return false, nil
+}
+
+func (g *GithubClient) GetCodeOwnersCoverage(req *request.CodeOwnersCoverageRequest, filter *AuthorFilter) (*response.CodeOwnersCoverageResponse, error) {
+	owner := req.Owner
+	repo := req.Repo
+	since := windowStart(req.Days)
+	depth := int(req.DirectoryDepth)
+	if depth <= 0 {
+		depth = defaultDirectoryDepth
+	}
+
+	file, location, err := g.fetchCodeOwners(owner, repo)
+	if err != nil {
+		return nil, err
+	}
+	if file == nil {
+		return nil, fmt.Errorf("no CODEOWNERS file found in %s/%s", owner, repo)
+	}
+
+	prs, err := g.listPullRequests(owner, repo, "closed", since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch PRs: %w", err)
+	}
+
+	resolver := &codeOwnerResolver{g: g, teams: make(map[string]map[string]bool), unresolved: make(map[string]bool)}
+	result := &response.CodeOwnersCoverageResponse{CodeownersPath: location}
+	unowned := make(map[string]*response.UnownedPath)
+	owners := make(map[string]*ownerLatency)
+
+	for _, pr := range prs {
+		if pr.MergedAt == nil || pr.MergedAt.Time.Before(since) {
+			continue
+		}
+		author := pr.GetUser().GetLogin()
+		if filter.Excludes(author, pr.GetUser().GetType()) {
+			continue
+		}
+		result.MergedPrs++
+
+		files, err := g.listPRFiles(owner, repo, pr.GetNumber())
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch files for PR #%d: %w", pr.GetNumber(), err)
+		}
+		required := make(map[string]bool)
+		for _, f := range files {
+			fileOwners := file.Owners(f.GetFilename())
+			if len(fileOwners) == 0 {
+				dir := directoryAt(f.GetFilename(), depth)
+				if unowned[dir] == nil {
+					unowned[dir] = &response.UnownedPath{Path: dir}
+				}
+				unowned[dir].FilesChanged++
+				continue
+			}
+			for _, o := range fileOwners {
+				required[o] = true
+			}
+		}
+		if len(required) == 0 {
+			result.PrsWithoutOwners++
+			continue
+		}
+		result.PrsRequiringOwners++
+
+		reviews, err := g.listReviews(owner, repo, pr.GetNumber())
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch reviews for PR #%d: %w", pr.GetNumber(), err)
+		}
+		approvedByOwner := false
+		for o := range required {
+			if owners[o] == nil {
+				owners[o] = &ownerLatency{stats: &response.OwnerReviewLatency{Owner: o}}
+			}
+			latency := owners[o]
+			latency.stats.PrsRequested++
+
+			var firstReview time.Time
+			for _, review := range reviews {
+				login := review.GetUser().GetLogin()
+				if login == author || review.SubmittedAt == nil {
+					continue
+				}
+				isOwner, err := resolver.includes(o, login)
+				if err != nil {
+					return nil, err
+				}
+				if !isOwner {
+					continue
+				}
+				if firstReview.IsZero() || review.SubmittedAt.Time.Before(firstReview) {
+					firstReview = review.SubmittedAt.Time
+				}
+				if strings.EqualFold(review.GetState(), "approved") {
+					approvedByOwner = true
+				}
+			}
+			if firstReview.IsZero() {
+				latency.stats.PrsUnreviewed++
+				continue
+			}
+			latency.latencies = append(latency.latencies, firstReview.Sub(pr.GetCreatedAt().Time))
+		}
+		if approvedByOwner {
+			result.ApprovedByCodeOwner++
+		}
+	}
+
+	if result.PrsRequiringOwners > 0 {
+		result.CodeOwnerApprovalRate = float32(result.ApprovedByCodeOwner) / float32(result.PrsRequiringOwners) * 100
+	}
+
+	for _, path := range unowned {
+		result.UnownedPaths = append(result.UnownedPaths, path)
+	}
+	sort.Slice(result.UnownedPaths, func(i, j int) bool {
+		a, b := result.UnownedPaths[i], result.UnownedPaths[j]
+		if a.FilesChanged != b.FilesChanged {
+			return a.FilesChanged > b.FilesChanged
+		}
+		return a.Path < b.Path
+	})
+
+	for _, o := range owners {
+		seconds := make([]float64, 0, len(o.latencies))
+		for _, d := range o.latencies {
+			seconds = append(seconds, d.Seconds())
+		}
+		o.median = math.Inf(1)
+		if len(seconds) > 0 {
+			o.median = stats.Median(seconds)
+		}
+		o.stats.MedianReviewLatency = medianDuration(o.latencies)
+		o.stats.P90ReviewLatency = percentileDuration(o.latencies, 90)
+		result.Owners = append(result.Owners, o.stats)
+	}
+	// Owners with the slowest median first review come first; owners who never reviewed are the
+	// slowest of all.
+	sort.Slice(result.Owners, func(i, j int) bool {
+		a, b := owners[result.Owners[i].Owner], owners[result.Owners[j].Owner]
+		if a.median != b.median {
+			return a.median > b.median
+		}
+		if a.stats.PrsUnreviewed != b.stats.PrsUnreviewed {
+			return a.stats.PrsUnreviewed > b.stats.PrsUnreviewed
+		}
+		return a.stats.Owner < b.stats.Owner
+	})
+
+	for o := range resolver.unresolved {
+		result.UnresolvedOwners = append(result.UnresolvedOwners, o)
+	}
+	sort.Strings(result.UnresolvedOwners)
+
+	return result, nil
+}
@@ -0,0 +1,429 @@
+package github
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bikash-789/comm-protos/luminex/v1/request"
+	"github.com/bikash-789/comm-protos/luminex/v1/response"
+	"github.com/google/go-github/v50/github"
+	"luminex-service/internal/helpers/conventional"
+	"luminex-service/internal/helpers/stats"
+)
+
+const defaultReleaseLimit = 20
+
+const (
+	changelogGroupByLabels       = "labels"
+	changelogGroupByConventional = "conventional"
+)
+
+const (
+	categoryBreaking      = "Breaking Changes"
+	categoryFeatures      = "Features"
+	categoryBugFixes      = "Bug Fixes"
+	categoryPerformance   = "Performance"
+	categoryDocumentation = "Documentation"
+	categoryRefactoring   = "Refactoring"
+	categoryDependencies  = "Dependencies"
+	categoryTests         = "Tests"
+	categoryBuild         = "Build & CI"
+	categoryChores        = "Chores"
+	categoryReverts       = "Reverts"
+	categoryOther         = "Other"
+)
+
+var changelogCategoryOrder = []string{
+	categoryBreaking, categoryFeatures, categoryBugFixes, categoryPerformance, categoryDocumentation,
+	categoryRefactoring, categoryDependencies, categoryTests, categoryBuild, categoryChores, categoryReverts, categoryOther,
+}
+
+var labelCategories = map[string]string{
+	"breaking":        categoryBreaking,
+	"breaking-change": categoryBreaking,
+	"feature":         categoryFeatures,
+	"enhancement":     categoryFeatures,
+	"bug":             categoryBugFixes,
+	"bugfix":          categoryBugFixes,
+	"fix":             categoryBugFixes,
+	"performance":     categoryPerformance,
+	"documentation":   categoryDocumentation,
+	"docs":            categoryDocumentation,
+	"refactor":        categoryRefactoring,
+	"dependencies":    categoryDependencies,
+	"tests":           categoryTests,
+	"ci":              categoryBuild,
+	"build":           categoryBuild,
+	"chore":           categoryChores,
+	"revert":          categoryReverts,
+}
+
+var conventionalCategories = map[string]string{
+	"feat":     categoryFeatures,
+	"fix":      categoryBugFixes,
+	"perf":     categoryPerformance,
+	"docs":     categoryDocumentation,
+	"refactor": categoryRefactoring,
+	"deps":     categoryDependencies,
+	"test":     categoryTests,
+	"build":    categoryBuild,
+	"ci":       categoryBuild,
+	"chore":    categoryChores,
+	"style":    categoryChores,
+	"revert":   categoryReverts,
+}
+
+// prReferencePattern finds the PR number in merge commits and in squash-merged commit titles.
+var prReferencePattern = regexp.MustCompile(`^Merge pull request #(\d+)|\(#(\d+)\)\s*$`)
+
+func pullRequestNumber(commitMessage string) int {
+	firstLine, _, _ := strings.Cut(commitMessage, "\n")
+	match := prReferencePattern.FindStringSubmatch(strings.TrimSpace(firstLine))
+	if match == nil {
+		return 0
+	}
+	number := match[1]
+	if number == "" {
+		number = match[2]
+	}
+	n, _ := strconv.Atoi(number)
+	return n
+}
+
+func (g *GithubClient) GetReleaseMetrics(req *request.ReleaseMetricsRequest, filter *AuthorFilter) (*response.ReleaseMetricsResponse, error) {
+	owner := req.Owner
+	repo := req.Repo
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultReleaseLimit
+	}
+
+	releases, err := g.listReleases(owner, repo, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch releases: %w", err)
+	}
+	sort.Slice(releases, func(i, j int) bool {
+		return releases[i].GetPublishedAt().Time.Before(releases[j].GetPublishedAt().Time)
+	})
+
+	tags, err := g.listTags(owner, repo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch tags: %w", err)
+	}
+
+	result := &response.ReleaseMetricsResponse{
+		Releases: make([]*response.ReleaseData, 0, len(releases)),
+		Tags:     make([]*response.TagData, 0, len(tags)),
+	}
+	for _, tag := range tags {
+		result.Tags = append(result.Tags, &response.TagData{
+			Name: tag.GetName(),
+			Sha:  tag.GetCommit().GetSHA(),
+		})
+	}
+
+	var gaps []float64
+	for i, release := range releases {
+		item := &response.ReleaseData{
+			TagName:     release.GetTagName(),
+			Name:        release.GetName(),
+			PublishedAt: release.GetPublishedAt().Format("2006-01-02T15:04:05Z"),
+			Prerelease:  release.GetPrerelease(),
+			Url:         release.GetHTMLURL(),
+		}
+
+		if i > 0 {
+			previous := releases[i-1]
+			gap := release.GetPublishedAt().Time.Sub(previous.GetPublishedAt().Time).Hours() / 24
+			item.DaysSincePrevious = float32(gap)
+			gaps = append(gaps, gap)
+
+			commits, err := g.compareCommits(owner, repo, previous.GetTagName(), release.GetTagName())
+			if err != nil {
+				return nil, fmt.Errorf("failed to compare %s...%s: %w", previous.GetTagName(), release.GetTagName(), err)
+			}
+			prs := make(map[int]bool)
+			contributors := make(map[string]bool)
+			for _, commit := range commits {
+				if number := pullRequestNumber(commit.GetCommit().GetMessage()); number > 0 {
+					prs[number] = true
+				}
+				if author := commitAuthor(commit); !filter.Excludes(author, commit.GetAuthor().GetType()) {
+					contributors[author] = true
+				}
+			}
+			item.Commits = int32(len(commits))
+			item.PullRequests = int32(len(prs))
+			item.Contributors = int32(len(contributors))
+		}
+
+		result.Releases = append(result.Releases, item)
+	}
+
+	result.AvgDaysBetweenReleases = float32(stats.Mean(gaps))
+	result.MedianDaysBetweenReleases = float32(stats.Median(gaps))
+
+	return result, nil
+}
+
+// GetChangelog lists the changes between two tags. Changes by excluded authors stay in the
+// changelog but their authors are left out of the contributors.
+func (g *GithubClient) GetChangelog(req *request.ChangelogRequest, filter *AuthorFilter) (*response.ChangelogResponse, error) {
+	owner := req.Owner
+	repo := req.Repo
+	if req.FromTag == "" || req.ToTag == "" {
+		return nil, fmt.Errorf("from_tag and to_tag are required")
+	}
+	commits, err := g.compareCommits(owner, repo, req.FromTag, req.ToTag)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compare %s...%s: %w", req.FromTag, req.ToTag, err)
+	}
+	prsByCommit, err := g.commitPullRequests(owner, repo, commits)
+	if err != nil {
+		return nil, err
+	}
+
+	categories := make(map[string][]*response.ChangelogEntry)
+	contributors := make(map[string]bool)
+	seen := make(map[int]bool)
+
+	for _, commit := range commits {
+		if author := commitAuthor(commit); !filter.Excludes(author, commit.GetAuthor().GetType()) {
+			contributors[author] = true
+		}
+
+		pr := prsByCommit[commit.GetSHA()]
+		var entry *response.ChangelogEntry
+		var labels []string
+		if pr != nil {
+			if seen[pr.GetNumber()] {
+				continue
+			}
+			seen[pr.GetNumber()] = true
+			entry = &response.ChangelogEntry{
+				Number: int32(pr.GetNumber()),
+				Title:  pr.GetTitle(),
+				Author: pr.GetUser().GetLogin(),
+				Url:    pr.GetHTMLURL(),
+			}
+			for _, label := range pr.Labels {
+				labels = append(labels, label.GetName())
+			}
+		} else {
+			message := commit.GetCommit().GetMessage()
+			title, _, _ := strings.Cut(message, "\n")
+			entry = &response.ChangelogEntry{
+				Title:  title,
+				Author: commitAuthor(commit),
+				Url:    commit.GetHTMLURL(),
+				Sha:    commit.GetSHA(),
+			}
+		}
+
+		category := categorizeChangelogEntry(entry, labels, commit.GetCommit().GetMessage(), req.GroupBy)
+		categories[category] = append(categories[category], entry)
+	}
+
+	result := &response.ChangelogResponse{
+		FromTag:    req.FromTag,
+		ToTag:      req.ToTag,
+		Categories: make([]*response.ChangelogCategory, 0, len(categories)),
+	}
+	for _, title := range changelogCategoryOrder {
+		if entries, ok := categories[title]; ok {
+			result.Categories = append(result.Categories, &response.ChangelogCategory{
+				Title:   title,
+				Entries: entries,
+			})
+		}
+	}
+	for contributor := range contributors {
+		result.Contributors = append(result.Contributors, contributor)
+	}
+	sort.Strings(result.Contributors)
+	result.Markdown = renderChangelogMarkdown(result)
+
+	return result, nil
+}
+
+// categorizeChangelogEntry fills in the conventional type and scope of the entry and picks its section.
+// Without an explicit groupBy, a known label wins and the conventional type is the fallback.
+func categorizeChangelogEntry(entry *response.ChangelogEntry, labels []string, commitMessage, groupBy string) string {
+	header, ok := conventional.Parse(entry.Title)
+	if !ok {
+		header, ok = conventional.Parse(commitMessage)
+	}
+	if ok {
+		entry.Type = header.Type
+		entry.Scope = header.Scope
+		entry.Breaking = header.Breaking
+	}
+
+	if groupBy != changelogGroupByConventional {
+		for _, label := range labels {
+			if category, found := labelCategories[strings.ToLower(label)]; found {
+				return category
+			}
+		}
+		if groupBy == changelogGroupByLabels {
+			return categoryOther
+		}
+	}
+
+	if entry.Breaking {
+		return categoryBreaking
+	}
+	if category, found := conventionalCategories[entry.Type]; found {
+		return category
+	}
+	return categoryOther
+}
+
+func renderChangelogMarkdown(changelog *response.ChangelogResponse) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "## %s...%s\n", changelog.FromTag, changelog.ToTag)
+
+	for _, category := range changelog.Categories {
+		fmt.Fprintf(&sb, "\n### %s\n\n", category.Title)
+		for _, entry := range category.Entries {
+			sb.WriteString("- ")
+			if entry.Scope != "" {
+				fmt.Fprintf(&sb, "**%s:** ", entry.Scope)
+			}
+			sb.WriteString(entry.Title)
+			if entry.Number > 0 {
+				fmt.Fprintf(&sb, " ([#%d](%s))", entry.Number, entry.Url)
+			} else if entry.Sha != "" {
+				fmt.Fprintf(&sb, " ([%s](%s))", shortSHA(entry.Sha), entry.Url)
+			}
+			if entry.Author != "" {
+				fmt.Fprintf(&sb, " @%s", entry.Author)
+			}
+			sb.WriteString("\n")
+		}
+	}
+
+	if len(changelog.Contributors) > 0 {
+		sb.WriteString("\n### Contributors\n\n")
+		for _, contributor := range changelog.Contributors {
+			fmt.Fprintf(&sb, "- @%s\n", contributor)
+		}
+	}
+
+	return sb.String()
+}
+
+// commitPullRequests maps the commits of a compared range to the merged PRs that brought them in,
+// using one listing of merged PRs rather than a lookup per commit. A commit on the first-parent
+// chain of the head belongs to the PR whose merge commit it is or that its message references;
+// the commits of a merged branch belong to the PR of the merge commit that brought them in.
+func (g *GithubClient) commitPullRequests(owner, repo string, commits []*github.RepositoryCommit) (map[string]*github.PullRequest, error) {
+	result := make(map[string]*github.PullRequest)
+	if len(commits) == 0 {
+		return result, nil
+	}
+	bySHA := make(map[string]*github.RepositoryCommit, len(commits))
+	for _, commit := range commits {
+		bySHA[commit.GetSHA()] = commit
+	}
+
+	// compare lists the head last
+	var mainline []*github.RepositoryCommit
+	onMainline := make(map[string]bool)
+	since := time.Now()
+	for commit := commits[len(commits)-1]; commit != nil && !onMainline[commit.GetSHA()]; {
+		mainline = append(mainline, commit)
+		onMainline[commit.GetSHA()] = true
+		if committed := commit.GetCommit().GetCommitter().GetDate().Time; !committed.IsZero() && committed.Before(since) {
+			since = committed
+		}
+		if len(commit.Parents) == 0 {
+			break
+		}
+		commit = bySHA[commit.Parents[0].GetSHA()]
+	}
+
+	// a PR merged into the range was last updated no earlier than its merge commit
+	prs, err := g.listPullRequests(owner, repo, "closed", since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch merged PRs: %w", err)
+	}
+	byNumber := make(map[int]*github.PullRequest)
+	byMergeSHA := make(map[string]*github.PullRequest)
+	for _, pr := range prs {
+		if pr.MergedAt != nil {
+			byNumber[pr.GetNumber()] = pr
+			byMergeSHA[pr.GetMergeCommitSHA()] = pr
+		}
+	}
+
+	for _, commit := range mainline {
+		pr := byMergeSHA[commit.GetSHA()]
+		if pr == nil {
+			pr = byNumber[pullRequestNumber(commit.GetCommit().GetMessage())]
+		}
+		if pr == nil {
+			continue
+		}
+		result[commit.GetSHA()] = pr
+
+		var branch []string
+		for i, parent := range commit.Parents {
+			if i > 0 {
+				branch = append(branch, parent.GetSHA())
+			}
+		}
+		for len(branch) > 0 {
+			sha := branch[0]
+			branch = branch[1:]
+			merged := bySHA[sha]
+			if merged == nil || onMainline[sha] || result[sha] != nil {
+				continue
+			}
+			result[sha] = pr
+			for _, parent := range merged.Parents {
+				branch = append(branch, parent.GetSHA())
+			}
+		}
+	}
+	return result, nil
+}
+
+func (g *GithubClient) pullRequestForCommit(owner, repo string, commit *github.RepositoryCommit) (*github.PullRequest, error) {
+	if number := pullRequestNumber(commit.GetCommit().GetMessage()); number > 0 {
+		pr, _, err := g.client.PullRequests.Get(g.ctx, owner, repo, number)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch PR #%d: %w", number, err)
+		}
+		return pr, nil
+	}
+
+	prs, _, err := g.client.PullRequests.ListPullRequestsWithCommit(g.ctx, owner, repo, commit.GetSHA(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch PRs for commit %s: %w", commit.GetSHA(), err)
+	}
+	for _, pr := range prs {
+		if pr.MergedAt != nil {
+			return pr, nil
+		}
+	}
+	return nil, nil
+}
+
+func commitAuthor(commit *github.RepositoryCommit) string {
+	if login := commit.GetAuthor().GetLogin(); login != "" {
+		return login
+	}
+	return commit.GetCommit().GetAuthor().GetName()
+}
+
+func shortSHA(sha string) string {
+	if len(sha) > 7 {
+		return sha[:7]
+	}
+	return sha
+}
@@ -0,0 +1,171 @@
+package github
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/bikash-789/comm-protos/luminex/v1/request"
+	"github.com/bikash-789/comm-protos/luminex/v1/response"
+	"github.com/google/go-github/v50/github"
+)
+
+const (
+	defaultRetentionMonths = 12
+	returnWindow           = 90 * 24 * time.Hour
+	monthLayout            = "2006-01"
+)
+
+func startOfMonth(t time.Time) time.Time {
+	year, month, _ := t.UTC().Date()
+	return time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+}
+
+func monthsBetween(from, to time.Time) int {
+	return (to.Year()-from.Year())*12 + int(to.Month()) - int(from.Month())
+}
+
+// returnedWithin reports whether the contributor came back on a later day within window of their first contribution.
+func returnedWithin(contributions []time.Time, window time.Duration) bool {
+	first := contributions[0]
+	firstDay := first.UTC().Truncate(24 * time.Hour)
+	for _, t := range contributions[1:] {
+		if t.Sub(first) > window {
+			return false
+		}
+		if t.UTC().Truncate(24 * time.Hour).After(firstDay) {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *GithubClient) GetContributorRetention(req *request.ContributorRetentionRequest, filter *AuthorFilter) (*response.ContributorRetentionResponse, error) {
+	owner := req.Owner
+	repo := req.Repo
+	months := int(req.Months)
+	if months <= 0 {
+		months = defaultRetentionMonths
+	}
+	now := time.Now().UTC()
+	windowStart := startOfMonth(now).AddDate(0, -(months - 1), 0)
+
+	// Only the window is listed; whether an active contributor is new is decided by checking for an
+	// earlier commit, one request per contributor instead of reading the whole history.
+	contributions := make(map[string][]time.Time)
+	prs, err := g.listPullRequests(owner, repo, "all", windowStart)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch PRs: %w", err)
+	}
+	for _, pr := range prs {
+		login := pr.GetUser().GetLogin()
+		if login == "" || filter.Excludes(login, pr.GetUser().GetType()) || pr.GetCreatedAt().Time.Before(windowStart) {
+			continue
+		}
+		contributions[login] = append(contributions[login], pr.GetCreatedAt().Time)
+	}
+	commits, err := g.listCommits(owner, repo, windowStart, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch commits: %w", err)
+	}
+	for _, commit := range commits {
+		login := commit.GetAuthor().GetLogin()
+		if login == "" || filter.Excludes(login, commit.GetAuthor().GetType()) {
+			continue
+		}
+		contributions[login] = append(contributions[login], commit.GetCommit().GetAuthor().GetDate().Time)
+	}
+
+	monthly := make([]*response.MonthlyContributors, months)
+	cohorts := make([]*response.RetentionCohort, months)
+	cohortMembers := make([][]string, months)
+	for i := range monthly {
+		month := windowStart.AddDate(0, i, 0).Format(monthLayout)
+		monthly[i] = &response.MonthlyContributors{Month: month}
+		cohorts[i] = &response.RetentionCohort{Month: month}
+	}
+
+	activeMonths := make(map[string]map[int]bool)
+	result := &response.ContributorRetentionResponse{}
+	var eligible, returned int
+	for login, times := range contributions {
+		sort.Slice(times, func(i, j int) bool { return times[i].Before(times[j]) })
+		first := times[0]
+		firstMonth := monthsBetween(windowStart, first.UTC())
+		earlier, err := g.committedBefore(owner, repo, login, windowStart)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch earlier commits of %s: %w", login, err)
+		}
+		if earlier {
+			firstMonth = -1
+		}
+
+		active := make(map[int]bool)
+		for _, t := range times {
+			if i := monthsBetween(windowStart, t.UTC()); i >= 0 && i < months {
+				active[i] = true
+			}
+		}
+		activeMonths[login] = active
+		for i := range active {
+			if i != firstMonth {
+				monthly[i].ReturningContributors++
+			}
+		}
+
+		if firstMonth < 0 || firstMonth >= months {
+			continue
+		}
+		monthly[firstMonth].NewContributors++
+		cohortMembers[firstMonth] = append(cohortMembers[firstMonth], login)
+		switch {
+		case returnedWithin(times, returnWindow):
+			monthly[firstMonth].ReturnedWithin_90Days++
+			eligible++
+			returned++
+		case now.Sub(first) < returnWindow:
+			monthly[firstMonth].PendingReturn++
+		default:
+			eligible++
+		}
+	}
+
+	for i, cohort := range cohorts {
+		cohort.Size = int32(len(cohortMembers[i]))
+		for offset := 0; i+offset < months; offset++ {
+			var retained int
+			for _, login := range cohortMembers[i] {
+				if activeMonths[login][i+offset] {
+					retained++
+				}
+			}
+			var rate float32
+			if cohort.Size > 0 {
+				rate = float32(retained) / float32(cohort.Size) * 100
+			}
+			cohort.RetentionPercent = append(cohort.RetentionPercent, rate)
+		}
+		result.NewContributors += cohort.Size
+	}
+	if eligible > 0 {
+		result.ReturnRate_90Days = float32(returned) / float32(eligible) * 100
+	}
+	result.Monthly = monthly
+	result.Cohorts = cohorts
+
+	return result, nil
+}
+
+// committedBefore reports whether login authored a commit before the given time. Contributors whose
+// only earlier activity is unmerged PRs count as new.
+func (g *GithubClient) committedBefore(owner, repo, login string, before time.Time) (bool, error) {
+	commits, _, err := g.client.Repositories.ListCommits(g.ctx, owner, repo, &github.CommitsListOptions{
+		Author:      login,
+		Until:       before,
+		ListOptions: github.ListOptions{PerPage: 1},
+	})
+	if err != nil {
+		return false, err
+	}
+	return len(commits) > 0, nil
+}
@@ -0,0 +1,225 @@
+package github
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bikash-789/comm-protos/luminex/v1/request"
+	"github.com/bikash-789/comm-protos/luminex/v1/response"
+	"github.com/google/go-github/v50/github"
+)
+
+const hotfixLabel = "hotfix"
+
+var (
+	revertTitlePattern   = regexp.MustCompile(`^Revert\s+"`)
+	revertBranchPattern  = regexp.MustCompile(`^revert-(\d+)-`)
+	revertsPRPattern     = regexp.MustCompile(`(?m)^Reverts\s+(?:[\w.-]+/[\w.-]+)?#(\d+)`)
+	revertsCommitPattern = regexp.MustCompile(`This reverts commit ([0-9a-f]{7,40})`)
+	hotfixBranchPattern  = regexp.MustCompile(`(?i)^hotfix[/_-]`)
+)
+
+type revert struct {
+	revertedBy string
+	revertedAt time.Time
+}
+
+// revertedPRNumber returns the PR undone by a revert PR opened from GitHub's "Revert" button.
+func revertedPRNumber(pr *github.PullRequest) int {
+	if match := revertsPRPattern.FindStringSubmatch(pr.GetBody()); match != nil {
+		n, _ := strconv.Atoi(match[1])
+		return n
+	}
+	if match := revertBranchPattern.FindStringSubmatch(pr.GetHead().GetRef()); match != nil {
+		n, _ := strconv.Atoi(match[1])
+		return n
+	}
+	return 0
+}
+
+func isHotfix(pr *github.PullRequest) bool {
+	if hotfixBranchPattern.MatchString(pr.GetHead().GetRef()) {
+		return true
+	}
+	for _, label := range pr.Labels {
+		if strings.EqualFold(label.GetName(), hotfixLabel) {
+			return true
+		}
+	}
+	return false
+}
+
+func revertCounts(counts map[string]*response.RevertCount) []*response.RevertCount {
+	result := make([]*response.RevertCount, 0, len(counts))
+	for _, c := range counts {
+		if c.Merged > 0 {
+			c.RevertRate = float32(c.Reverted) / float32(c.Merged) * 100
+		}
+		result = append(result, c)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Reverted != result[j].Reverted {
+			return result[i].Reverted > result[j].Reverted
+		}
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
+
+// revertedAreas lists the directories touched by reverted PRs. Merged PRs are not listed per
+// directory, so areas carry counts but no rate.
+func revertedAreas(areas map[string]*response.RevertedArea) []*response.RevertedArea {
+	result := make([]*response.RevertedArea, 0, len(areas))
+	for _, a := range areas {
+		result = append(result, a)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Reverted != result[j].Reverted {
+			return result[i].Reverted > result[j].Reverted
+		}
+		return result[i].Path < result[j].Path
+	})
+	return result
+}
+
+func (g *GithubClient) GetReverts(req *request.RevertsRequest, filter *AuthorFilter) (*response.RevertsResponse, error) {
+	owner := req.Owner
+	repo := req.Repo
+	since := windowStart(req.Days)
+
+	prs, err := g.listPullRequests(owner, repo, "closed", since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch PRs: %w", err)
+	}
+
+	result := &response.RevertsResponse{}
+	authors := make(map[string]*response.RevertCount)
+	areas := make(map[string]*response.RevertedArea)
+	count := func(counts map[string]*response.RevertCount, name string) *response.RevertCount {
+		if counts[name] == nil {
+			counts[name] = &response.RevertCount{Name: name}
+		}
+		return counts[name]
+	}
+
+	reverts := make(map[int]*revert)
+	revertPRs := make(map[int]bool)
+	for _, pr := range prs {
+		if pr.MergedAt == nil || pr.MergedAt.Time.Before(since) {
+			continue
+		}
+		if filter.Excludes(pr.GetUser().GetLogin(), pr.GetUser().GetType()) {
+			continue
+		}
+		result.MergedPrs++
+		count(authors, pr.GetUser().GetLogin()).Merged++
+
+		if revertTitlePattern.MatchString(pr.GetTitle()) {
+			revertPRs[pr.GetNumber()] = true
+			if original := revertedPRNumber(pr); original > 0 && reverts[original] == nil {
+				reverts[original] = &revert{revertedBy: fmt.Sprintf("#%d", pr.GetNumber()), revertedAt: pr.MergedAt.Time}
+			}
+		}
+		if isHotfix(pr) {
+			result.HotfixPrs++
+			result.Hotfixes = append(result.Hotfixes, &response.HotfixPR{
+				Number:   int32(pr.GetNumber()),
+				Title:    pr.GetTitle(),
+				Author:   pr.GetUser().GetLogin(),
+				Branch:   pr.GetHead().GetRef(),
+				Url:      pr.GetHTMLURL(),
+				MergedAt: pr.MergedAt.Format("2006-01-02T15:04:05Z"),
+			})
+		}
+	}
+
+	// Reverts pushed without a revert PR are found through the trailer added by git revert.
+	commits, err := g.listCommits(owner, repo, since, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch commits: %w", err)
+	}
+	for _, commit := range commits {
+		match := revertsCommitPattern.FindStringSubmatch(commit.GetCommit().GetMessage())
+		if match == nil || revertPRs[pullRequestNumber(commit.GetCommit().GetMessage())] {
+			continue
+		}
+		reverted, _, err := g.client.Repositories.GetCommit(g.ctx, owner, repo, match[1], nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch reverted commit %s: %w", shortSHA(match[1]), err)
+		}
+		original, err := g.pullRequestForCommit(owner, repo, reverted)
+		if err != nil {
+			return nil, err
+		}
+		if original != nil && reverts[original.GetNumber()] == nil {
+			reverts[original.GetNumber()] = &revert{
+				revertedBy: shortSHA(commit.GetSHA()),
+				revertedAt: commit.GetCommit().GetCommitter().GetDate().Time,
+			}
+		}
+	}
+
+	var timesToRevert []time.Duration
+	for number, r := range reverts {
+		original, _, err := g.client.PullRequests.Get(g.ctx, owner, repo, number)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch PR #%d: %w", number, err)
+		}
+		if original.MergedAt == nil || filter.Excludes(original.GetUser().GetLogin(), original.GetUser().GetType()) {
+			continue
+		}
+		timeToRevert := r.revertedAt.Sub(original.MergedAt.Time)
+		timesToRevert = append(timesToRevert, timeToRevert)
+
+		// Only PRs merged in the window count towards the rates, whose denominator is the PRs
+		// merged in the window; reverts of older PRs are still listed.
+		if original.MergedAt.Time.Before(since) {
+			result.RevertedBeforeWindow++
+		} else {
+			result.RevertedPrs++
+			count(authors, original.GetUser().GetLogin()).Reverted++
+
+			files, err := g.listPRFiles(owner, repo, number)
+			if err != nil {
+				return nil, fmt.Errorf("failed to fetch files for PR #%d: %w", number, err)
+			}
+			touched := make(map[string]bool)
+			for _, file := range files {
+				touched[directoryAt(file.GetFilename(), defaultDirectoryDepth)] = true
+			}
+			for dir := range touched {
+				if areas[dir] == nil {
+					areas[dir] = &response.RevertedArea{Path: dir}
+				}
+				areas[dir].Reverted++
+			}
+		}
+
+		result.Reverts = append(result.Reverts, &response.RevertedChange{
+			Number:       int32(number),
+			Title:        original.GetTitle(),
+			Author:       original.GetUser().GetLogin(),
+			Url:          original.GetHTMLURL(),
+			RevertedBy:   r.revertedBy,
+			RevertedAt:   r.revertedAt.Format("2006-01-02T15:04:05Z"),
+			TimeToRevert: timeToRevert.Round(time.Second).String(),
+		})
+	}
+	sort.Slice(result.Reverts, func(i, j int) bool {
+		return result.Reverts[i].RevertedAt > result.Reverts[j].RevertedAt
+	})
+
+	if result.MergedPrs > 0 {
+		result.RevertRate = float32(result.RevertedPrs) / float32(result.MergedPrs) * 100
+		result.HotfixRate = float32(result.HotfixPrs) / float32(result.MergedPrs) * 100
+	}
+	result.MedianTimeToRevert = medianDuration(timesToRevert)
+	result.Authors = revertCounts(authors)
+	result.Areas = revertedAreas(areas)
+
+	return result, nil
+}
@@ -0,0 +1,124 @@
+package github
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bikash-789/comm-protos/luminex/v1/request"
+	"github.com/bikash-789/comm-protos/luminex/v1/response"
+)
+
+type reviewerActivity struct {
+	stats         *response.ReviewerStats
+	responseTimes []time.Duration
+}
+
+func (g *GithubClient) GetReviewerWorkload(req *request.ReviewerWorkloadRequest, filter *AuthorFilter) (*response.ReviewerWorkloadResponse, error) {
+	since := windowStart(req.Days)
+	prs, err := g.listPullRequests(req.Owner, req.Repo, "all", since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch PRs: %w", err)
+	}
+
+	activity := make(map[string]*reviewerActivity)
+	reviewer := func(login string) *reviewerActivity {
+		if activity[login] == nil {
+			activity[login] = &reviewerActivity{stats: &response.ReviewerStats{Username: login}}
+		}
+		return activity[login]
+	}
+
+	var allResponseTimes []time.Duration
+	for _, pr := range prs {
+		author := pr.GetUser().GetLogin()
+
+		if pr.GetState() == "open" {
+			for _, requested := range pr.RequestedReviewers {
+				if filter.Excludes(requested.GetLogin(), requested.GetType()) {
+					continue
+				}
+				reviewer(requested.GetLogin()).stats.OutstandingRequests++
+			}
+		}
+
+		events, err := g.listTimeline(req.Owner, req.Repo, pr.GetNumber())
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch timeline for PR #%d: %w", pr.GetNumber(), err)
+		}
+
+		pendingSince := make(map[string]time.Time)
+		for _, event := range events {
+			switch event.GetEvent() {
+			case "review_requested":
+				if event.Reviewer == nil || event.CreatedAt == nil {
+					continue
+				}
+				login := event.Reviewer.GetLogin()
+				if filter.Excludes(login, event.Reviewer.GetType()) {
+					continue
+				}
+				if _, pending := pendingSince[login]; !pending {
+					pendingSince[login] = event.CreatedAt.Time
+				}
+				if event.CreatedAt.Time.After(since) {
+					reviewer(login).stats.ReviewRequests++
+				}
+			case "reviewed":
+				login := event.GetUser().GetLogin()
+				if login == "" || login == author || event.SubmittedAt == nil {
+					continue
+				}
+				if filter.Excludes(login, event.GetUser().GetType()) {
+					continue
+				}
+				if requestedAt, pending := pendingSince[login]; pending {
+					delete(pendingSince, login)
+					if event.SubmittedAt.Time.After(since) {
+						responseTime := event.SubmittedAt.Time.Sub(requestedAt)
+						reviewer(login).responseTimes = append(reviewer(login).responseTimes, responseTime)
+						allResponseTimes = append(allResponseTimes, responseTime)
+					}
+				}
+				if event.SubmittedAt.Time.Before(since) {
+					continue
+				}
+				stats := reviewer(login).stats
+				stats.ReviewsSubmitted++
+				switch strings.ToLower(event.GetState()) {
+				case "approved":
+					stats.Approvals++
+				case "changes_requested":
+					stats.ChangesRequested++
+				case "commented":
+					stats.Comments++
+				}
+			}
+		}
+	}
+
+	result := &response.ReviewerWorkloadResponse{
+		Reviewers:          make([]*response.ReviewerStats, 0, len(activity)),
+		MedianResponseTime: medianDuration(allResponseTimes),
+	}
+	for _, a := range activity {
+		a.stats.MedianResponseTime = medianDuration(a.responseTimes)
+		result.TotalReviewRequests += a.stats.ReviewRequests
+		result.TotalReviewsSubmitted += a.stats.ReviewsSubmitted
+		result.TotalOutstandingRequests += a.stats.OutstandingRequests
+		result.Reviewers = append(result.Reviewers, a.stats)
+	}
+
+	sort.Slice(result.Reviewers, func(i, j int) bool {
+		if result.Reviewers[i].OutstandingRequests != result.Reviewers[j].OutstandingRequests {
+			return result.Reviewers[i].OutstandingRequests > result.Reviewers[j].OutstandingRequests
+		}
+		if result.Reviewers[i].ReviewRequests != result.Reviewers[j].ReviewRequests {
+			return result.Reviewers[i].ReviewRequests > result.Reviewers[j].ReviewRequests
+		}
+		return result.Reviewers[i].Username < result.Reviewers[j].Username
+	})
+
+	return result, nil
+}
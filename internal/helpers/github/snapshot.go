@@ -0,0 +1,83 @@
+package github
+
+import (
+	"fmt"
+	"time"
+
+	"luminex-service/internal/helpers/stats"
+)
+
+// RepositorySnapshot holds the point-in-time repository figures exported as gauges.
+type RepositorySnapshot struct {
+	OpenPRs           int
+	MergedPRs         int
+	MergeTimeP50Hours float64
+	MergeTimeP90Hours float64
+	OpenIssues        int
+	Stars             int
+	Forks             int
+	CIRuns            int32
+	CISuccessRate     float64 // percentage, 0-100
+}
+
+// GetRepositorySnapshot counts open PRs and issues, and PRs merged and CI runs over the last days. It is
+// called on every metrics sync, so CI health comes from the run list only.
+func (g *GithubClient) GetRepositorySnapshot(owner, repo string, days int32, filter *AuthorFilter) (*RepositorySnapshot, error) {
+	if days <= 0 {
+		days = defaultWindowDays
+	}
+	since := windowStart(days)
+
+	repository, _, err := g.client.Repositories.Get(g.ctx, owner, repo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch repository data: %w", err)
+	}
+	snapshot := &RepositorySnapshot{
+		Stars: repository.GetStargazersCount(),
+		Forks: repository.GetForksCount(),
+	}
+
+	openPRs, err := g.listPullRequests(owner, repo, "open", time.Time{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch open PRs: %w", err)
+	}
+	for _, pr := range openPRs {
+		if !filter.Excludes(pr.GetUser().GetLogin(), pr.GetUser().GetType()) {
+			snapshot.OpenPRs++
+		}
+	}
+
+	closedPRs, err := g.listPullRequests(owner, repo, "closed", since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch closed PRs: %w", err)
+	}
+	var hours []float64
+	for _, pr := range closedPRs {
+		if pr.MergedAt == nil || pr.MergedAt.Time.Before(since) || filter.Excludes(pr.GetUser().GetLogin(), pr.GetUser().GetType()) {
+			continue
+		}
+		hours = append(hours, pr.MergedAt.Time.Sub(pr.GetCreatedAt().Time).Hours())
+	}
+	snapshot.MergedPRs = len(hours)
+	snapshot.MergeTimeP50Hours = stats.Median(hours)
+	snapshot.MergeTimeP90Hours = stats.Percentile(hours, 90)
+
+	issues, err := g.listIssues(owner, repo, "open", time.Time{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch open issues: %w", err)
+	}
+	for _, issue := range issues {
+		if !filter.Excludes(issue.GetUser().GetLogin(), issue.GetUser().GetType()) {
+			snapshot.OpenIssues++
+		}
+	}
+
+	ci, err := g.GetCISummary(owner, repo, since, time.Time{})
+	if err != nil {
+		return nil, err
+	}
+	snapshot.CIRuns = ci.Runs
+	snapshot.CISuccessRate = float64(ci.SuccessRate)
+
+	return snapshot, nil
+}
@@ -0,0 +1,173 @@
+package github
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bikash-789/comm-protos/luminex/v1/request"
+	"github.com/bikash-789/comm-protos/luminex/v1/response"
+	"github.com/google/go-github/v50/github"
+	"luminex-service/internal/conf"
+)
+
+const (
+	riskWeightConflict     = 3
+	riskWeightFailingCheck = 3
+	riskWeightReviewSLA    = 2
+	riskWeightInactive     = 2
+	riskWeightLargeDiff    = 1
+)
+
+func (g *GithubClient) GetAtRiskPRs(req *request.RepositoryRequest, thresholds *conf.StaleThresholds, filter *AuthorFilter) (*response.AtRiskPRsResponse, error) {
+	owner := req.Owner
+	repo := req.Repo
+	prs, err := g.listPullRequests(owner, repo, "open", time.Time{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch PRs: %w", err)
+	}
+
+	result := &response.AtRiskPRsResponse{
+		Prs:            make([]*response.AtRiskPR, 0),
+		InactiveDays:   thresholds.InactiveDays,
+		ReviewSlaHours: thresholds.ReviewSlaHours,
+		LargeDiffLines: thresholds.LargeDiffLines,
+	}
+	now := time.Now()
+
+	for _, listed := range prs {
+		if filter.Excludes(listed.GetUser().GetLogin(), listed.GetUser().GetType()) {
+			continue
+		}
+
+		pr, _, err := g.client.PullRequests.Get(g.ctx, owner, repo, listed.GetNumber())
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch PR #%d: %w", listed.GetNumber(), err)
+		}
+
+		item := &response.AtRiskPR{
+			Number:         int32(pr.GetNumber()),
+			Title:          pr.GetTitle(),
+			Author:         pr.GetUser().GetLogin(),
+			Url:            pr.GetHTMLURL(),
+			CreatedAt:      pr.GetCreatedAt().Format("2006-01-02T15:04:05Z"),
+			LastActivity:   pr.GetUpdatedAt().Format("2006-01-02T15:04:05Z"),
+			DaysInactive:   int32(now.Sub(pr.GetUpdatedAt().Time).Hours() / 24),
+			LinesChanged:   int32(pr.GetAdditions() + pr.GetDeletions()),
+			MergeableState: pr.GetMergeableState(),
+			Reasons:        make([]string, 0),
+		}
+
+		if item.DaysInactive >= thresholds.InactiveDays {
+			item.RiskScore += riskWeightInactive
+			item.Reasons = append(item.Reasons, fmt.Sprintf("no activity for %d days", item.DaysInactive))
+		}
+
+		if !pr.GetDraft() {
+			waiting, err := g.waitingOnReview(owner, repo, pr)
+			if err != nil {
+				return nil, err
+			}
+			if waiting > time.Duration(thresholds.ReviewSlaHours)*time.Hour {
+				item.RiskScore += riskWeightReviewSLA
+				item.Reasons = append(item.Reasons, fmt.Sprintf("waiting on review for %s", waiting.Round(time.Hour)))
+			}
+		}
+
+		if pr.GetMergeableState() == "dirty" {
+			item.RiskScore += riskWeightConflict
+			item.Reasons = append(item.Reasons, "has merge conflicts")
+		}
+
+		failing, err := g.failingChecks(owner, repo, pr.GetHead().GetSHA())
+		if err != nil {
+			return nil, err
+		}
+		if len(failing) > 0 {
+			item.RiskScore += riskWeightFailingCheck
+			item.Reasons = append(item.Reasons, "failing checks: "+strings.Join(failing, ", "))
+		}
+
+		if item.LinesChanged >= thresholds.LargeDiffLines {
+			item.RiskScore += riskWeightLargeDiff
+			item.Reasons = append(item.Reasons, fmt.Sprintf("large diff of %d lines", item.LinesChanged))
+		}
+
+		if item.RiskScore > 0 {
+			result.Prs = append(result.Prs, item)
+		}
+	}
+
+	sort.SliceStable(result.Prs, func(i, j int) bool {
+		if result.Prs[i].RiskScore != result.Prs[j].RiskScore {
+			return result.Prs[i].RiskScore > result.Prs[j].RiskScore
+		}
+		return result.Prs[i].DaysInactive > result.Prs[j].DaysInactive
+	})
+
+	return result, nil
+}
+
+// waitingOnReview returns how long the PR has waited since its latest review request, or since it
+// was opened when no review was ever requested, without a review from anyone other than its author.
+func (g *GithubClient) waitingOnReview(owner, repo string, pr *github.PullRequest) (time.Duration, error) {
+	events, err := g.listTimeline(owner, repo, pr.GetNumber())
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch timeline for PR #%d: %w", pr.GetNumber(), err)
+	}
+
+	waitingSince := pr.GetCreatedAt().Time
+	reviewed := false
+	for _, event := range events {
+		switch event.GetEvent() {
+		case "review_requested":
+			if event.CreatedAt != nil {
+				waitingSince = event.CreatedAt.Time
+				reviewed = false
+			}
+		case "reviewed":
+			if event.GetUser().GetLogin() != pr.GetUser().GetLogin() && event.SubmittedAt != nil {
+				reviewed = true
+			}
+		}
+	}
+	if reviewed {
+		return 0, nil
+	}
+	return time.Since(waitingSince), nil
+}
+
+func (g *GithubClient) failingChecks(owner, repo, sha string) ([]string, error) {
+	var failing []string
+
+	opts := &github.ListCheckRunsOptions{ListOptions: github.ListOptions{PerPage: 100}}
+	for {
+		runs, resp, err := g.client.Checks.ListCheckRunsForRef(g.ctx, owner, repo, sha, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch check runs for %s: %w", sha, err)
+		}
+		for _, run := range runs.CheckRuns {
+			switch run.GetConclusion() {
+			case "failure", "timed_out", "cancelled", "action_required":
+				failing = append(failing, run.GetName())
+			}
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	status, _, err := g.client.Repositories.GetCombinedStatus(g.ctx, owner, repo, sha, &github.ListOptions{PerPage: 100})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch commit status for %s: %w", sha, err)
+	}
+	for _, s := range status.Statuses {
+		if s.GetState() == "failure" || s.GetState() == "error" {
+			failing = append(failing, s.GetContext())
+		}
+	}
+
+	return failing, nil
+}
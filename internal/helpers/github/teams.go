@@ -0,0 +1,304 @@
+package github
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bikash-789/comm-protos/luminex/v1/request"
+	"github.com/bikash-789/comm-protos/luminex/v1/response"
+	"github.com/google/go-github/v50/github"
+)
+
+type teamMembership struct {
+	teams     []string
+	members   map[string]bool
+	userTypes map[string]string
+}
+
+// sortedMembers lists the members the filter keeps.
+func (m *teamMembership) sortedMembers(filter *AuthorFilter) []string {
+	members := make([]string, 0, len(m.members))
+	for member := range m.members {
+		if !filter.Excludes(member, m.userTypes[member]) {
+			members = append(members, member)
+		}
+	}
+	sort.Strings(members)
+	return members
+}
+
+// involvedIn reports whether a member opened or is assigned to issue.
+func (m *teamMembership) involvedIn(issue *github.Issue) bool {
+	if m.members[issue.GetUser().GetLogin()] {
+		return true
+	}
+	for _, assignee := range issue.Assignees {
+		if m.members[assignee.GetLogin()] {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveTeam collects the members of a team and of all of its nested child teams.
+func (g *GithubClient) resolveTeam(org, slug string) (*teamMembership, error) {
+	membership := &teamMembership{members: make(map[string]bool), userTypes: make(map[string]string)}
+	visited := make(map[string]bool)
+
+	var visit func(slug string) error
+	visit = func(slug string) error {
+		if visited[slug] {
+			return nil
+		}
+		visited[slug] = true
+		membership.teams = append(membership.teams, slug)
+
+		memberOpts := &github.TeamListTeamMembersOptions{ListOptions: github.ListOptions{PerPage: 100}}
+		for {
+			users, resp, err := g.client.Teams.ListTeamMembersBySlug(g.ctx, org, slug, memberOpts)
+			if err != nil {
+				return fmt.Errorf("failed to fetch members of team %s: %w", slug, err)
+			}
+			for _, user := range users {
+				membership.members[user.GetLogin()] = true
+				membership.userTypes[user.GetLogin()] = user.GetType()
+			}
+			if resp.NextPage == 0 {
+				break
+			}
+			memberOpts.Page = resp.NextPage
+		}
+
+		childOpts := &github.ListOptions{PerPage: 100}
+		for {
+			children, resp, err := g.client.Teams.ListChildTeamsByParentSlug(g.ctx, org, slug, childOpts)
+			if err != nil {
+				return fmt.Errorf("failed to fetch child teams of %s: %w", slug, err)
+			}
+			for _, child := range children {
+				if err := visit(child.GetSlug()); err != nil {
+					return err
+				}
+			}
+			if resp.NextPage == 0 {
+				break
+			}
+			childOpts.Page = resp.NextPage
+		}
+		return nil
+	}
+
+	if err := visit(slug); err != nil {
+		return nil, err
+	}
+	return membership, nil
+}
+
+// teamNotVisible reports whether resolving a team failed because the team does not exist or the
+// token may not read it, rather than because of a transient or rate limit error.
+func teamNotVisible(err error) bool {
+	var errResp *github.ErrorResponse
+	if !errors.As(err, &errResp) || errResp.Response == nil {
+		return false
+	}
+	return errResp.Response.StatusCode == http.StatusNotFound || errResp.Response.StatusCode == http.StatusForbidden
+}
+
+// teamMembers resolves a team given as "org/slug"; it returns nil for an empty team.
+func (g *GithubClient) teamMembers(team string) (map[string]bool, error) {
+	if team == "" {
+		return nil, nil
+	}
+	org, slug, ok := strings.Cut(team, "/")
+	if !ok || org == "" || slug == "" {
+		return nil, fmt.Errorf("invalid team %q, expected org/slug", team)
+	}
+	membership, err := g.resolveTeam(org, slug)
+	if err != nil {
+		return nil, err
+	}
+	return membership.members, nil
+}
+
+func (g *GithubClient) GetTeamMembers(req *request.TeamRequest, filter *AuthorFilter) (*response.TeamMembersResponse, error) {
+	membership, err := g.resolveTeam(req.Org, req.TeamSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.TeamMembersResponse{
+		Org:      req.Org,
+		TeamSlug: req.TeamSlug,
+		Teams:    membership.teams,
+		Members:  membership.sortedMembers(filter),
+	}, nil
+}
+
+func (g *GithubClient) GetTeamMetrics(req *request.TeamMetricsRequest, filter *AuthorFilter) (*response.TeamMetricsResponse, error) {
+	membership, err := g.resolveTeam(req.Org, req.TeamSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	since := windowStart(req.Days)
+	load := make(map[string]*response.TeamMemberLoad)
+	memberLoad := func(login string) *response.TeamMemberLoad {
+		if load[login] == nil {
+			load[login] = &response.TeamMemberLoad{Username: login}
+		}
+		return load[login]
+	}
+
+	result := &response.TeamMetricsResponse{
+		Org:      req.Org,
+		TeamSlug: req.TeamSlug,
+		Teams:    membership.teams,
+		Members:  membership.sortedMembers(filter),
+	}
+
+	var totalCycleTime, totalFirstReview, totalResolutionTime time.Duration
+	var cycleCount, firstReviewCount, resolutionCount int
+
+	for _, repository := range req.Repos {
+		prs, err := g.listPullRequests(repository.Owner, repository.Repo, "all", since)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch PRs for %s/%s: %w", repository.Owner, repository.Repo, err)
+		}
+
+		// open PRs the team reviewed in the window, as reviews are only fetched for those
+		reviewedOpen := make(map[int]bool)
+		for _, pr := range prs {
+			if filter.Excludes(pr.GetUser().GetLogin(), pr.GetUser().GetType()) {
+				continue
+			}
+			reviews, err := g.listReviews(repository.Owner, repository.Repo, pr.GetNumber())
+			if err != nil {
+				return nil, fmt.Errorf("failed to fetch reviews for %s/%s#%d: %w", repository.Owner, repository.Repo, pr.GetNumber(), err)
+			}
+
+			author := pr.GetUser().GetLogin()
+			relevant := membership.members[author]
+			var firstReview *time.Time
+			for _, review := range reviews {
+				reviewer := review.GetUser().GetLogin()
+				if reviewer == author || review.SubmittedAt == nil || filter.Excludes(reviewer, review.GetUser().GetType()) {
+					continue
+				}
+				if firstReview == nil || review.SubmittedAt.Time.Before(*firstReview) {
+					submitted := review.SubmittedAt.Time
+					firstReview = &submitted
+				}
+				if membership.members[reviewer] {
+					relevant = true
+					if review.SubmittedAt.Time.After(since) {
+						result.ReviewsGiven++
+						memberLoad(reviewer).ReviewsGiven++
+					}
+				}
+			}
+			if !relevant {
+				continue
+			}
+
+			if pr.GetState() == "open" {
+				reviewedOpen[pr.GetNumber()] = true
+			}
+			if pr.CreatedAt != nil && pr.CreatedAt.Time.After(since) {
+				result.PrsOpened++
+				if membership.members[author] {
+					memberLoad(author).PrsAuthored++
+				}
+				if firstReview != nil {
+					totalFirstReview += firstReview.Sub(pr.CreatedAt.Time)
+					firstReviewCount++
+				}
+			}
+			if pr.MergedAt != nil && pr.MergedAt.Time.After(since) {
+				result.PrsMerged++
+				if membership.members[author] {
+					memberLoad(author).PrsMerged++
+				}
+				totalCycleTime += pr.MergedAt.Time.Sub(pr.CreatedAt.Time)
+				cycleCount++
+			}
+		}
+
+		// open counts are a snapshot, so they include items not updated in the window
+		openPRs, err := g.listPullRequests(repository.Owner, repository.Repo, "open", time.Time{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch open PRs for %s/%s: %w", repository.Owner, repository.Repo, err)
+		}
+		for _, pr := range openPRs {
+			if filter.Excludes(pr.GetUser().GetLogin(), pr.GetUser().GetType()) {
+				continue
+			}
+			relevant := reviewedOpen[pr.GetNumber()] || membership.members[pr.GetUser().GetLogin()]
+			for _, users := range [][]*github.User{pr.RequestedReviewers, pr.Assignees} {
+				for _, user := range users {
+					if membership.members[user.GetLogin()] {
+						relevant = true
+					}
+				}
+			}
+			if relevant {
+				result.OpenPrs++
+			}
+		}
+
+		openIssues, err := g.listIssues(repository.Owner, repository.Repo, "open", time.Time{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch open issues for %s/%s: %w", repository.Owner, repository.Repo, err)
+		}
+		for _, issue := range openIssues {
+			if filter.Excludes(issue.GetUser().GetLogin(), issue.GetUser().GetType()) {
+				continue
+			}
+			if membership.involvedIn(issue) {
+				result.OpenIssues++
+			}
+		}
+
+		issues, err := g.listIssues(repository.Owner, repository.Repo, "all", since)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch issues for %s/%s: %w", repository.Owner, repository.Repo, err)
+		}
+
+		for _, issue := range issues {
+			if filter.Excludes(issue.GetUser().GetLogin(), issue.GetUser().GetType()) {
+				continue
+			}
+			if !membership.involvedIn(issue) {
+				continue
+			}
+
+			if issue.CreatedAt != nil && issue.CreatedAt.Time.After(since) {
+				result.IssuesOpened++
+			}
+			if issue.ClosedAt != nil && issue.ClosedAt.Time.After(since) {
+				result.IssuesClosed++
+				totalResolutionTime += issue.ClosedAt.Time.Sub(issue.CreatedAt.Time)
+				resolutionCount++
+			}
+		}
+	}
+
+	result.AvgCycleTime = averageDuration(totalCycleTime, cycleCount)
+	result.AvgTimeToFirstReview = averageDuration(totalFirstReview, firstReviewCount)
+	result.AvgIssueResolutionTime = averageDuration(totalResolutionTime, resolutionCount)
+	if len(result.Members) > 0 {
+		result.AvgReviewsPerMember = float32(result.ReviewsGiven) / float32(len(result.Members))
+	}
+
+	for _, member := range result.Members {
+		result.MemberLoad = append(result.MemberLoad, memberLoad(member))
+	}
+	sort.SliceStable(result.MemberLoad, func(i, j int) bool {
+		return result.MemberLoad[i].ReviewsGiven > result.MemberLoad[j].ReviewsGiven
+	})
+
+	return result, nil
+}
@@ -0,0 +1,239 @@
+package github
+
+import (
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"luminex-service/internal/helpers/telemetry"
+)
+
+const (
+	maxCachedResponses = 2000
+	maxCachedBytes     = 64 << 20
+	maxCachedBodyBytes = 1 << 20
+	defaultRateLimit   = "core"
+)
+
+type cachedResponse struct {
+	key          string
+	etag         string
+	lastModified string
+	header       http.Header
+	body         []byte
+}
+
+// response rebuilds the cached response for a 304, taking the rate limit headers from the revalidation.
+func (c *cachedResponse) response(req *http.Request, revalidated http.Header) *http.Response {
+	header := c.header.Clone()
+	for name, values := range revalidated {
+		if strings.HasPrefix(name, "X-Ratelimit-") || name == "Date" {
+			header[name] = values
+		}
+	}
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(c.body)),
+		ContentLength: int64(len(c.body)),
+		Request:       req,
+	}
+}
+
+// instrumentedTransport counts GitHub API calls, records the rate limit of its token and revalidates
+// cached GET responses with conditional requests, which GitHub does not count against the rate limit.
+type instrumentedTransport struct {
+	base  http.RoundTripper
+	token string
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	order   *list.List
+	size    int
+}
+
+func newInstrumentedTransport(base http.RoundTripper, token string) *instrumentedTransport {
+	return &instrumentedTransport{
+		base:    base,
+		token:   tokenFingerprint(token),
+		entries: make(map[string]*list.Element),
+		order:   list.New(),
+	}
+}
+
+// tokenFingerprint identifies a token in metric labels without exposing it.
+func tokenFingerprint(token string) string {
+	if token == "" {
+		return "anonymous"
+	}
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:4])
+}
+
+func (t *instrumentedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	endpoint := githubEndpoint(req.URL.Path)
+	cacheable := req.Method == http.MethodGet
+	key := req.URL.String() + " " + req.Header.Get("Accept")
+
+	var cached *cachedResponse
+	if cacheable {
+		if cached = t.lookup(key); cached != nil {
+			req = req.Clone(req.Context())
+			if cached.etag != "" {
+				req.Header.Set("If-None-Match", cached.etag)
+			}
+			if cached.lastModified != "" {
+				req.Header.Set("If-Modified-Since", cached.lastModified)
+			}
+		}
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		telemetry.GithubRequest(req.Method, endpoint, 0)
+		return nil, err
+	}
+	telemetry.GithubRequest(req.Method, endpoint, resp.StatusCode)
+	t.recordRateLimit(resp.Header)
+	if !cacheable {
+		return resp, nil
+	}
+
+	if resp.StatusCode == http.StatusNotModified && cached != nil {
+		telemetry.CacheHit()
+		resp.Body.Close()
+		return cached.response(req, resp.Header), nil
+	}
+	telemetry.CacheMiss()
+
+	etag, lastModified := resp.Header.Get("ETag"), resp.Header.Get("Last-Modified")
+	if resp.StatusCode != http.StatusOK || (etag == "" && lastModified == "") || resp.ContentLength > maxCachedBodyBytes {
+		return resp, nil
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxCachedBodyBytes+1))
+	if err != nil {
+		resp.Body.Close()
+		return nil, err
+	}
+	if len(body) > maxCachedBodyBytes {
+		resp.Body = readCloser{io.MultiReader(bytes.NewReader(body), resp.Body), resp.Body}
+		return resp, nil
+	}
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	t.store(&cachedResponse{key: key, etag: etag, lastModified: lastModified, header: resp.Header.Clone(), body: body})
+	return resp, nil
+}
+
+// readCloser streams a partly read body, closing the original one.
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+func (t *instrumentedTransport) recordRateLimit(header http.Header) {
+	limit, err := strconv.Atoi(header.Get("X-RateLimit-Limit"))
+	if err != nil {
+		return
+	}
+	remaining, _ := strconv.Atoi(header.Get("X-RateLimit-Remaining"))
+	reset, _ := strconv.ParseInt(header.Get("X-RateLimit-Reset"), 10, 64)
+	resource := header.Get("X-RateLimit-Resource")
+	if resource == "" {
+		resource = defaultRateLimit
+	}
+	telemetry.GithubRateLimit(t.token, resource, limit, remaining, reset)
+}
+
+func (t *instrumentedTransport) lookup(key string) *cachedResponse {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	element, ok := t.entries[key]
+	if !ok {
+		return nil
+	}
+	t.order.MoveToFront(element)
+	return element.Value.(*cachedResponse)
+}
+
+// store keeps the most recently used responses, evicting the least recently used ones once either
+// the number of responses or their total body size goes over its limit.
+func (t *instrumentedTransport) store(entry *cachedResponse) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if element, ok := t.entries[entry.key]; ok {
+		t.size -= len(element.Value.(*cachedResponse).body)
+		element.Value = entry
+		t.order.MoveToFront(element)
+	} else {
+		t.entries[entry.key] = t.order.PushFront(entry)
+	}
+	t.size += len(entry.body)
+	for t.order.Len() > maxCachedResponses || t.size > maxCachedBytes {
+		oldest := t.order.Back()
+		t.order.Remove(oldest)
+		evicted := oldest.Value.(*cachedResponse)
+		delete(t.entries, evicted.key)
+		t.size -= len(evicted.body)
+	}
+}
+
+// githubEndpoint replaces the owner, repository, team, numbers, SHAs and free-form path parts of an
+// API path with placeholders to keep the endpoint label bounded.
+func githubEndpoint(path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i := 0; i < len(segments); i++ {
+		previous := ""
+		if i > 0 {
+			previous = segments[i-1]
+		}
+		switch {
+		case segments[0] == "repos" && i == 1:
+			segments[i] = "{owner}"
+		case segments[0] == "repos" && i == 2:
+			segments[i] = "{repo}"
+		case (segments[0] == "orgs" || segments[0] == "users") && i == 1:
+			segments[i] = "{" + strings.TrimSuffix(segments[0], "s") + "}"
+		case segments[0] == "orgs" && previous == "teams":
+			segments[i] = "{team}"
+		case previous == "compare":
+			segments[i] = "{basehead}"
+		case previous == "tags" || previous == "ref" || previous == "refs":
+			segments[i] = "{ref}"
+			segments = segments[:i+1]
+		case previous == "contents":
+			segments[i] = "{path}"
+			segments = segments[:i+1]
+		case isNumeric(segments[i]):
+			segments[i] = "{id}"
+		case isCommitSHA(segments[i]):
+			segments[i] = "{sha}"
+		}
+	}
+	return "/" + strings.Join(segments, "/")
+}
+
+func isNumeric(s string) bool {
+	_, err := strconv.ParseInt(s, 10, 64)
+	return err == nil
+}
+
+func isCommitSHA(s string) bool {
+	if len(s) != 40 {
+		return false
+	}
+	_, err := hex.DecodeString(s)
+	return err == nil
+}
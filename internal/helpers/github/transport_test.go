@@ -0,0 +1,119 @@
+package github
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestGithubEndpoint(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"/repos/octo/hello/pulls", "/repos/{owner}/{repo}/pulls"},
+		{"/repos/octo/hello/pulls/42/reviews", "/repos/{owner}/{repo}/pulls/{id}/reviews"},
+		{"/repos/octo/hello/commits/0123456789abcdef0123456789abcdef01234567", "/repos/{owner}/{repo}/commits/{sha}"},
+		{"/repos/octo/hello/compare/v1.0.0...v1.1.0", "/repos/{owner}/{repo}/compare/{basehead}"},
+		{"/repos/octo/hello/git/ref/heads/feature/x", "/repos/{owner}/{repo}/git/ref/{ref}"},
+		{"/repos/octo/hello/contents/docs/README.md", "/repos/{owner}/{repo}/contents/{path}"},
+		{"/orgs/octo/teams/backend/members", "/orgs/{org}/teams/{team}/members"},
+		{"/users/octocat/repos", "/users/{user}/repos"},
+		{"/rate_limit", "/rate_limit"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			if got := githubEndpoint(tt.path); got != tt.want {
+				t.Errorf("githubEndpoint(%q) = %q, want %q", tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+func get(t *testing.T, client *http.Client, url string) (int, string) {
+	t.Helper()
+	resp, err := client.Get(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp.StatusCode, string(body)
+}
+
+func TestTransportRevalidatesCachedResponses(t *testing.T) {
+	var conditional []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conditional = append(conditional, r.Header.Get("If-None-Match"))
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.Header().Set("X-RateLimit-Remaining", "4999")
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		w.Write([]byte(`[{"number":1}]`))
+	}))
+	defer server.Close()
+
+	transport := newInstrumentedTransport(http.DefaultTransport, "token")
+	client := &http.Client{Transport: transport}
+
+	for i := 0; i < 2; i++ {
+		status, body := get(t, client, server.URL+"/repos/o/r/pulls")
+		if status != http.StatusOK || body != `[{"number":1}]` {
+			t.Fatalf("request %d: got %d %q", i, status, body)
+		}
+	}
+	if len(conditional) != 2 || conditional[0] != "" || conditional[1] != `"v1"` {
+		t.Errorf("If-None-Match headers = %q, want none then the cached ETag", conditional)
+	}
+}
+
+func TestTransportSkipsLargeBodies(t *testing.T) {
+	large := strings.Repeat("x", maxCachedBodyBytes+1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", `"v1"`)
+		if r.URL.Path == "/chunked" {
+			// Flushing before writing the body forces chunked encoding, leaving the length unknown.
+			w.(http.Flusher).Flush()
+		}
+		w.Write([]byte(large))
+	}))
+	defer server.Close()
+
+	transport := newInstrumentedTransport(http.DefaultTransport, "token")
+	client := &http.Client{Transport: transport}
+
+	for _, path := range []string{"/sized", "/chunked"} {
+		if _, body := get(t, client, server.URL+path); body != large {
+			t.Errorf("%s: body of %d bytes, want %d", path, len(body), len(large))
+		}
+	}
+	if len(transport.entries) != 0 || transport.size != 0 {
+		t.Errorf("cached %d responses (%d bytes), want none", len(transport.entries), transport.size)
+	}
+}
+
+func TestTransportEvictsOverByteLimit(t *testing.T) {
+	transport := newInstrumentedTransport(http.DefaultTransport, "token")
+	body := make([]byte, maxCachedBodyBytes)
+	total := maxCachedBytes/maxCachedBodyBytes + 1
+	for i := 0; i < total; i++ {
+		transport.store(&cachedResponse{key: strings.Repeat("k", i+1), body: body})
+	}
+
+	if transport.size > maxCachedBytes {
+		t.Errorf("cache holds %d bytes, want at most %d", transport.size, maxCachedBytes)
+	}
+	if transport.lookup("k") != nil {
+		t.Error("least recently used response was not evicted")
+	}
+	if transport.lookup(strings.Repeat("k", total)) == nil {
+		t.Error("most recent response was evicted")
+	}
+}
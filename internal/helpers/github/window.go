@@ -0,0 +1,38 @@
+package github
+
+import (
+	"time"
+
+	"luminex-service/internal/helpers/stats"
+)
+
+const defaultWindowDays = 30
+
+func windowStart(days int32) time.Time {
+	if days <= 0 {
+		days = defaultWindowDays
+	}
+	return time.Now().AddDate(0, 0, -int(days))
+}
+
+func averageDuration(total time.Duration, count int) string {
+	if count == 0 {
+		return "N/A"
+	}
+	return (total / time.Duration(count)).String()
+}
+
+func medianDuration(durations []time.Duration) string {
+	return percentileDuration(durations, 50)
+}
+
+func percentileDuration(durations []time.Duration, percentile float64) string {
+	if len(durations) == 0 {
+		return "N/A"
+	}
+	seconds := make([]float64, 0, len(durations))
+	for _, d := range durations {
+		seconds = append(seconds, d.Seconds())
+	}
+	return time.Duration(stats.Percentile(seconds, percentile) * float64(time.Second)).Round(time.Second).String()
+}
@@ -0,0 +1,150 @@
+package notify
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"luminex-service/internal/conf"
+)
+
+const (
+	mimeBoundary = "luminex-alternative"
+	smtpTimeout  = 30 * time.Second
+)
+
+type smtpCredentials struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+type emailSink struct {
+	name string
+	addr string
+	from string
+	to   []string
+	auth smtp.Auth
+}
+
+func newEmailSink(config *conf.NotificationSink) (*emailSink, error) {
+	if config.GetSmtpAddr() == "" || config.GetFrom() == "" || len(config.GetTo()) == 0 {
+		return nil, fmt.Errorf("sink %q: smtp_addr, from and to are required", config.GetName())
+	}
+	sink := &emailSink{
+		name: config.GetName(),
+		addr: config.GetSmtpAddr(),
+		from: config.GetFrom(),
+		to:   config.GetTo(),
+	}
+
+	if location := config.GetSecretFileLocation(); location != "" {
+		var credentials smtpCredentials
+		if err := conf.GetSecret(location, &credentials); err != nil {
+			return nil, fmt.Errorf("sink %q: %w", config.GetName(), err)
+		}
+		host, _, err := net.SplitHostPort(sink.addr)
+		if err != nil {
+			return nil, fmt.Errorf("sink %q: invalid smtp_addr %q: %w", config.GetName(), sink.addr, err)
+		}
+		sink.auth = smtp.PlainAuth("", credentials.Username, credentials.Password, host)
+	}
+	return sink, nil
+}
+
+func (s *emailSink) Name() string {
+	return s.name
+}
+
+// Send delivers the message as plain text, or as multipart/alternative when it has an HTML body.
+// The whole SMTP exchange is bounded by smtpTimeout and the context deadline, so a hung server
+// cannot block the alerting or digest loops.
+func (s *emailSink) Send(ctx context.Context, message *Message) error {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "From: %s\r\n", s.from)
+	fmt.Fprintf(&sb, "To: %s\r\n", strings.Join(s.to, ", "))
+	fmt.Fprintf(&sb, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", message.Subject))
+	fmt.Fprintf(&sb, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	sb.WriteString("MIME-Version: 1.0\r\n")
+	if message.HTML == "" {
+		sb.WriteString("Content-Type: text/plain; charset=utf-8\r\n\r\n")
+		sb.WriteString(message.Text)
+	} else {
+		fmt.Fprintf(&sb, "Content-Type: multipart/alternative; boundary=%s\r\n\r\n", mimeBoundary)
+		fmt.Fprintf(&sb, "--%s\r\nContent-Type: text/plain; charset=utf-8\r\n\r\n%s\r\n", mimeBoundary, message.Text)
+		fmt.Fprintf(&sb, "--%s\r\nContent-Type: text/html; charset=utf-8\r\n\r\n%s\r\n", mimeBoundary, message.HTML)
+		fmt.Fprintf(&sb, "--%s--\r\n", mimeBoundary)
+	}
+
+	if err := s.send(ctx, []byte(sb.String())); err != nil {
+		return fmt.Errorf("failed to send email via %s: %w", s.addr, err)
+	}
+	return nil
+}
+
+// send mirrors smtp.SendMail on a connection with a deadline.
+func (s *emailSink) send(ctx context.Context, msg []byte) error {
+	host, _, err := net.SplitHostPort(s.addr)
+	if err != nil {
+		return err
+	}
+	deadline := time.Now().Add(smtpTimeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+
+	dialer := &net.Dialer{Deadline: deadline}
+	conn, err := dialer.DialContext(ctx, "tcp", s.addr)
+	if err != nil {
+		return err
+	}
+	if err := conn.SetDeadline(deadline); err != nil {
+		conn.Close()
+		return err
+	}
+
+	client, err := smtp.NewClient(conn, host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: host}); err != nil {
+			return err
+		}
+	}
+	if s.auth != nil {
+		if ok, _ := client.Extension("AUTH"); !ok {
+			return errors.New("server does not support AUTH")
+		}
+		if err := client.Auth(s.auth); err != nil {
+			return err
+		}
+	}
+	if err := client.Mail(s.from); err != nil {
+		return err
+	}
+	for _, to := range s.to {
+		if err := client.Rcpt(to); err != nil {
+			return err
+		}
+	}
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(msg); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
@@ -0,0 +1,60 @@
+// Package notify delivers messages to the configured notification sinks: generic JSON webhooks,
+// Slack-compatible incoming webhooks and SMTP email.
+package notify
+
+import (
+	"context"
+	"fmt"
+
+	"luminex-service/internal/conf"
+)
+
+const (
+	SinkWebhook = "webhook"
+	SinkSlack   = "slack"
+	SinkEmail   = "email"
+)
+
+type Message struct {
+	Subject string
+	Text    string
+	HTML    string
+	// Data is sent as the body of generic webhooks; Subject, Text and HTML are sent when it is nil.
+	Data interface{}
+}
+
+type Sink interface {
+	Name() string
+	Send(ctx context.Context, message *Message) error
+}
+
+func NewSink(config *conf.NotificationSink) (Sink, error) {
+	switch config.GetType() {
+	case SinkWebhook:
+		return newWebhookSink(config)
+	case SinkSlack:
+		return newSlackSink(config)
+	case SinkEmail:
+		return newEmailSink(config)
+	}
+	return nil, fmt.Errorf("sink %q: unsupported type %q, expected %s, %s or %s", config.GetName(), config.GetType(), SinkWebhook, SinkSlack, SinkEmail)
+}
+
+// NewSinks builds the configured sinks, keyed by name.
+func NewSinks(configs []*conf.NotificationSink) (map[string]Sink, error) {
+	sinks := make(map[string]Sink, len(configs))
+	for _, config := range configs {
+		if config.GetName() == "" {
+			return nil, fmt.Errorf("notification sink of type %q has no name", config.GetType())
+		}
+		if _, exists := sinks[config.GetName()]; exists {
+			return nil, fmt.Errorf("duplicate notification sink %q", config.GetName())
+		}
+		sink, err := NewSink(config)
+		if err != nil {
+			return nil, err
+		}
+		sinks[config.GetName()] = sink
+	}
+	return sinks, nil
+}
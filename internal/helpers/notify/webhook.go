@@ -0,0 +1,126 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	neturl "net/url"
+	"os"
+	"time"
+
+	"luminex-service/internal/conf"
+)
+
+const webhookTimeout = 10 * time.Second
+
+var httpClient = &http.Client{Timeout: webhookTimeout}
+
+type webhookSink struct {
+	name string
+	url  string
+}
+
+type webhookSecret struct {
+	URL string `json:"url"`
+}
+
+// webhookURL reads the URL of a webhook or Slack sink from its secret file or environment variable;
+// webhook URLs carry their own credentials, so they are not accepted in plain config.
+func webhookURL(config *conf.NotificationSink) (string, error) {
+	var url string
+	switch {
+	case config.GetSecretFileLocation() != "":
+		var secret webhookSecret
+		if err := conf.GetSecret(config.GetSecretFileLocation(), &secret); err != nil {
+			return "", fmt.Errorf("sink %q: %w", config.GetName(), err)
+		}
+		url = secret.URL
+	case config.GetUrlEnv() != "":
+		url = os.Getenv(config.GetUrlEnv())
+	default:
+		return "", fmt.Errorf("sink %q: secret_file_location or url_env is required", config.GetName())
+	}
+	if url == "" {
+		return "", fmt.Errorf("sink %q: webhook url is empty", config.GetName())
+	}
+	return url, nil
+}
+
+func newWebhookSink(config *conf.NotificationSink) (*webhookSink, error) {
+	url, err := webhookURL(config)
+	if err != nil {
+		return nil, err
+	}
+	return &webhookSink{name: config.GetName(), url: url}, nil
+}
+
+func (s *webhookSink) Name() string {
+	return s.name
+}
+
+func (s *webhookSink) Send(ctx context.Context, message *Message) error {
+	body := message.Data
+	if body == nil {
+		body = map[string]string{
+			"subject": message.Subject,
+			"text":    message.Text,
+			"html":    message.HTML,
+		}
+	}
+	return postJSON(ctx, s.url, body)
+}
+
+type slackSink struct {
+	name string
+	url  string
+}
+
+func newSlackSink(config *conf.NotificationSink) (*slackSink, error) {
+	url, err := webhookURL(config)
+	if err != nil {
+		return nil, err
+	}
+	return &slackSink{name: config.GetName(), url: url}, nil
+}
+
+func (s *slackSink) Name() string {
+	return s.name
+}
+
+func (s *slackSink) Send(ctx context.Context, message *Message) error {
+	return postJSON(ctx, s.url, map[string]string{
+		"text": fmt.Sprintf("*%s*\n%s", message.Subject, message.Text),
+	})
+}
+
+// postJSON posts body as JSON. Errors name only the host, as the URL itself is a secret.
+func postJSON(ctx context.Context, url string, body interface{}) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("failed to encode payload: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return errors.New("invalid webhook url")
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		var urlErr *neturl.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		return fmt.Errorf("failed to post to %s: %w", req.URL.Host, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("post to %s returned %s: %s", req.URL.Host, resp.Status, bytes.TrimSpace(respBody))
+	}
+	return nil
+}
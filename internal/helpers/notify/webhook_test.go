@@ -0,0 +1,150 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"luminex-service/internal/conf"
+)
+
+type capturedRequest struct {
+	contentType string
+	body        map[string]interface{}
+}
+
+func newTestServer(t *testing.T, status int) (*httptest.Server, *[]capturedRequest) {
+	var requests []capturedRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		payload, _ := io.ReadAll(r.Body)
+		var body map[string]interface{}
+		if err := json.Unmarshal(payload, &body); err != nil {
+			t.Errorf("invalid JSON payload %q: %v", payload, err)
+		}
+		requests = append(requests, capturedRequest{contentType: r.Header.Get("Content-Type"), body: body})
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+func TestSinks(t *testing.T) {
+	type alert struct {
+		Rule   string `json:"rule"`
+		Status string `json:"status"`
+	}
+
+	tests := []struct {
+		name     string
+		sinkType string
+		message  *Message
+		want     map[string]interface{}
+	}{
+		{
+			name:     "webhook sends data",
+			sinkType: SinkWebhook,
+			message:  &Message{Subject: "s", Text: "t", Data: &alert{Rule: "open-prs", Status: "firing"}},
+			want:     map[string]interface{}{"rule": "open-prs", "status": "firing"},
+		},
+		{
+			name:     "webhook without data sends the message",
+			sinkType: SinkWebhook,
+			message:  &Message{Subject: "s", Text: "t", HTML: "<p>t</p>"},
+			want:     map[string]interface{}{"subject": "s", "text": "t", "html": "<p>t</p>"},
+		},
+		{
+			name:     "slack sends formatted text",
+			sinkType: SinkSlack,
+			message:  &Message{Subject: "[FIRING] open-prs", Text: "open_prs is 8", Data: &alert{Rule: "open-prs"}},
+			want:     map[string]interface{}{"text": "*[FIRING] open-prs*\nopen_prs is 8"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, requests := newTestServer(t, http.StatusOK)
+			t.Setenv("TEST_WEBHOOK_URL", server.URL+"/hook")
+
+			sink, err := NewSink(&conf.NotificationSink{Name: "test", Type: tt.sinkType, UrlEnv: "TEST_WEBHOOK_URL"})
+			if err != nil {
+				t.Fatalf("NewSink() error = %v", err)
+			}
+			if err := sink.Send(context.Background(), tt.message); err != nil {
+				t.Fatalf("Send() error = %v", err)
+			}
+			if len(*requests) != 1 {
+				t.Fatalf("server received %d requests, want 1", len(*requests))
+			}
+			got := (*requests)[0]
+			if got.contentType != "application/json" {
+				t.Errorf("Content-Type = %q, want application/json", got.contentType)
+			}
+			gotJSON, _ := json.Marshal(got.body)
+			wantJSON, _ := json.Marshal(tt.want)
+			if string(gotJSON) != string(wantJSON) {
+				t.Errorf("body = %s, want %s", gotJSON, wantJSON)
+			}
+		})
+	}
+}
+
+func TestSinkErrorsHideURL(t *testing.T) {
+	server, _ := newTestServer(t, http.StatusForbidden)
+	t.Setenv("TEST_WEBHOOK_URL", server.URL+"/services/T000/B000/SECRET")
+
+	sink, err := NewSink(&conf.NotificationSink{Name: "team-slack", Type: SinkSlack, UrlEnv: "TEST_WEBHOOK_URL"})
+	if err != nil {
+		t.Fatalf("NewSink() error = %v", err)
+	}
+	err = sink.Send(context.Background(), &Message{Subject: "s", Text: "t"})
+	if err == nil {
+		t.Fatal("Send() error = nil, want an error for a 403 response")
+	}
+	if strings.Contains(err.Error(), "SECRET") {
+		t.Errorf("error %q leaks the webhook URL", err)
+	}
+
+	server.Close()
+	err = sink.Send(context.Background(), &Message{Subject: "s", Text: "t"})
+	if err == nil || strings.Contains(err.Error(), "SECRET") {
+		t.Errorf("connection error = %v, want an error without the webhook URL", err)
+	}
+}
+
+func TestWebhookURL(t *testing.T) {
+	secretFile := filepath.Join(t.TempDir(), "slack.json")
+	if err := os.WriteFile(secretFile, []byte(`{"url": "https://hooks.example.com/from-file"}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("TEST_WEBHOOK_URL", "https://hooks.example.com/from-env")
+
+	tests := []struct {
+		name    string
+		config  *conf.NotificationSink
+		want    string
+		wantErr bool
+	}{
+		{name: "secret file", config: &conf.NotificationSink{Name: "s", SecretFileLocation: secretFile}, want: "https://hooks.example.com/from-file"},
+		{name: "environment", config: &conf.NotificationSink{Name: "s", UrlEnv: "TEST_WEBHOOK_URL"}, want: "https://hooks.example.com/from-env"},
+		{name: "empty environment", config: &conf.NotificationSink{Name: "s", UrlEnv: "TEST_UNSET_WEBHOOK_URL"}, wantErr: true},
+		{name: "missing secret file", config: &conf.NotificationSink{Name: "s", SecretFileLocation: secretFile + ".missing"}, wantErr: true},
+		{name: "not configured", config: &conf.NotificationSink{Name: "s"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := webhookURL(tt.config)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("webhookURL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("webhookURL() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
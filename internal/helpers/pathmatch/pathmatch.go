@@ -0,0 +1,116 @@
+// Package pathmatch matches repository paths against gitignore-style globs,
+// the syntax used by CODEOWNERS files and by the path exclusion settings.
+package pathmatch
+
+import (
+	"regexp"
+	"strings"
+)
+
+type Pattern struct {
+	raw     string
+	re      *regexp.Regexp
+	dirOnly bool
+	// descendants is set when a match on a directory also covers everything below it.
+	descendants bool
+}
+
+// Compile translates a glob into a Pattern. Patterns without a slash match at any depth,
+// a leading slash anchors to the repository root and a trailing slash only matches directories.
+// As in CODEOWNERS, a directory match covers everything below it for directory patterns, patterns
+// without a slash and patterns ending in a literal name (/apps/github), but a trailing wildcard only
+// matches direct children: docs/* matches docs/a.md and not docs/build/a.md.
+func Compile(pattern string) (*Pattern, error) {
+	raw := strings.TrimSpace(pattern)
+	glob := raw
+	dirOnly := strings.HasSuffix(glob, "/")
+	glob = strings.TrimSuffix(glob, "/")
+
+	anchored := strings.HasPrefix(glob, "/") || strings.Contains(glob, "/")
+	lastSegment := glob[strings.LastIndex(glob, "/")+1:]
+	descendants := dirOnly || !anchored || !strings.ContainsAny(lastSegment, "*?")
+	glob = strings.TrimPrefix(glob, "/")
+	if !anchored {
+		glob = "**/" + glob
+	}
+
+	var sb strings.Builder
+	sb.WriteString("^")
+	for i := 0; i < len(glob); i++ {
+		switch c := glob[i]; c {
+		case '*':
+			if i+1 < len(glob) && glob[i+1] == '*' {
+				if i+2 < len(glob) && glob[i+2] == '/' {
+					sb.WriteString("(?:.*/)?")
+					i += 2
+				} else {
+					sb.WriteString(".*")
+					i++
+				}
+			} else {
+				sb.WriteString("[^/]*")
+			}
+		case '?':
+			sb.WriteString("[^/]")
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	sb.WriteString("$")
+
+	re, err := regexp.Compile(sb.String())
+	if err != nil {
+		return nil, err
+	}
+	return &Pattern{raw: raw, re: re, dirOnly: dirOnly, descendants: descendants}, nil
+}
+
+func CompileAll(patterns []string) ([]*Pattern, error) {
+	compiled := make([]*Pattern, 0, len(patterns))
+	for _, pattern := range patterns {
+		p, err := Compile(pattern)
+		if err != nil {
+			return nil, err
+		}
+		compiled = append(compiled, p)
+	}
+	return compiled, nil
+}
+
+func (p *Pattern) String() string {
+	return p.raw
+}
+
+func (p *Pattern) Match(path string) bool {
+	candidate := strings.TrimPrefix(path, "/")
+	if p.dirOnly {
+		i := strings.LastIndex(candidate, "/")
+		if i < 0 {
+			return false
+		}
+		candidate = candidate[:i]
+	}
+
+	for {
+		if p.re.MatchString(candidate) {
+			return true
+		}
+		if !p.descendants {
+			return false
+		}
+		i := strings.LastIndex(candidate, "/")
+		if i < 0 {
+			return false
+		}
+		candidate = candidate[:i]
+	}
+}
+
+func MatchAny(patterns []*Pattern, path string) bool {
+	for _, p := range patterns {
+		if p.Match(path) {
+			return true
+		}
+	}
+	return false
+}
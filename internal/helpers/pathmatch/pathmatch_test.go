@@ -0,0 +1,84 @@
+package pathmatch
+
+import "testing"
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		pattern string
+		path    string
+		want    bool
+	}{
+		// Patterns without a slash match names at any depth, including directories.
+		{"*.js", "app.js", true},
+		{"*.js", "src/web/app.js", true},
+		{"*.js", "src/app.ts", false},
+		{"go.sum", "go.sum", true},
+		{"go.sum", "tools/go.sum", true},
+		{"build", "build/out/app.bin", true},
+
+		// A trailing wildcard only matches direct children.
+		{"docs/*", "docs/getting-started.md", true},
+		{"docs/*", "docs/build/troubleshooting.md", false},
+		{"/docs/*.md", "docs/a.md", true},
+		{"/docs/*.md", "docs/guides/a.md", false},
+		{"src/*/main.go", "src/api/main.go", true},
+		{"src/*/main.go", "src/api/v1/main.go", false},
+
+		// Directory patterns cover everything below them.
+		{"apps/", "apps/web/index.ts", true},
+		{"apps/", "services/apps/web/index.ts", true},
+		{"apps/", "apps", false},
+		{"/docs/", "docs/a/b/c.md", true},
+		{"/docs/", "src/docs/c.md", false},
+		{"vendor/", "vendor/github.com/x/y.go", true},
+
+		// Anchored patterns ending in a literal name cover the directory's contents.
+		{"/apps/github", "apps/github/app.go", true},
+		{"/apps/github", "apps/github", true},
+		{"/apps/github", "apps/githubber/app.go", false},
+		{"/build/logs", "src/build/logs/a.log", false},
+
+		// Double-star patterns.
+		{"docs/**", "docs/build/troubleshooting.md", true},
+		{"**/logs", "deeply/nested/logs/a.log", true},
+		{"**/logs", "logs/a.log", true},
+		{"/scripts/**/test", "scripts/a/b/test/run.sh", true},
+
+		{"*", "anything/at/all.txt", true},
+		{"a?c.txt", "abc.txt", true},
+		{"a?c.txt", "a/c.txt", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern+" "+tt.path, func(t *testing.T) {
+			p, err := Compile(tt.pattern)
+			if err != nil {
+				t.Fatalf("Compile(%q) error = %v", tt.pattern, err)
+			}
+			if got := p.Match(tt.path); got != tt.want {
+				t.Errorf("Compile(%q).Match(%q) = %v, want %v", tt.pattern, tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchAny(t *testing.T) {
+	patterns, err := CompileAll([]string{"go.sum", "vendor/", "*.pb.go"})
+	if err != nil {
+		t.Fatalf("CompileAll() error = %v", err)
+	}
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{"go.sum", true},
+		{"api/v1/service.pb.go", true},
+		{"vendor/golang.org/x/net/http.go", true},
+		{"internal/service/service.go", false},
+	}
+	for _, tt := range tests {
+		if got := MatchAny(patterns, tt.path); got != tt.want {
+			t.Errorf("MatchAny(%q) = %v, want %v", tt.path, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+// Package stats holds the small numeric helpers shared by the metric calculations.
+package stats
+
+import (
+	"math"
+	"sort"
+)
+
+// Pearson returns the Pearson correlation coefficient of two equally sized samples,
+// or 0 when it is undefined.
+func Pearson(xs, ys []float64) float64 {
+	if len(xs) != len(ys) || len(xs) < 2 {
+		return 0
+	}
+
+	meanX, meanY := Mean(xs), Mean(ys)
+	var cov, varX, varY float64
+	for i := range xs {
+		dx, dy := xs[i]-meanX, ys[i]-meanY
+		cov += dx * dy
+		varX += dx * dx
+		varY += dy * dy
+	}
+	if varX == 0 || varY == 0 {
+		return 0
+	}
+	return cov / math.Sqrt(varX*varY)
+}
+
+func Mean(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	return sum / float64(len(values))
+}
+
+// Percentile returns the p-th percentile (0-100) of values using linear interpolation between closest ranks.
+func Percentile(values []float64, p float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+
+	rank := p / 100 * float64(len(sorted)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+	if lower == upper {
+		return sorted[lower]
+	}
+	return sorted[lower] + (sorted[upper]-sorted[lower])*(rank-float64(lower))
+}
+
+func Median(values []float64) float64 {
+	return Percentile(values, 50)
+}
+
+// MAD returns the median absolute deviation of values from their median.
+func MAD(values []float64) float64 {
+	median := Median(values)
+	deviations := make([]float64, 0, len(values))
+	for _, v := range values {
+		deviations = append(deviations, math.Abs(v-median))
+	}
+	return Median(deviations)
+}
+
+// RobustZScore scores value against baseline using the median and MAD, scaled so that it is
+// comparable to a standard z-score for normally distributed data. When most of the baseline is
+// identical, e.g. a series of mostly zeros, the MAD is 0 and the mean absolute deviation is used
+// instead; against a constant baseline any deviation scores as infinite. It reports false for an
+// empty baseline.
+func RobustZScore(value float64, baseline []float64) (float64, bool) {
+	if len(baseline) == 0 {
+		return 0, false
+	}
+	median := Median(baseline)
+	if mad := MAD(baseline); mad != 0 {
+		return 0.6745 * (value - median) / mad, true
+	}
+
+	var meanAD float64
+	for _, v := range baseline {
+		meanAD += math.Abs(v - median)
+	}
+	meanAD /= float64(len(baseline))
+	switch {
+	case meanAD != 0:
+		return (value - median) / (1.2533 * meanAD), true
+	case value > median:
+		return math.Inf(1), true
+	case value < median:
+		return math.Inf(-1), true
+	}
+	return 0, true
+}
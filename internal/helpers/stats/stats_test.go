@@ -0,0 +1,31 @@
+package stats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRobustZScore(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    float64
+		baseline []float64
+		want     float64
+		ok       bool
+	}{
+		{name: "spread baseline uses MAD", value: 10, baseline: []float64{1, 2, 3, 4, 5}, want: 0.6745 * 7, ok: true},
+		{name: "mostly zeros falls back to mean deviation", value: 5, baseline: []float64{0, 0, 0, 0, 0, 0, 2}, want: 5 / (1.2533 * 2.0 / 7), ok: true},
+		{name: "spike over constant baseline", value: 3, baseline: []float64{0, 0, 0, 0}, want: math.Inf(1), ok: true},
+		{name: "collapse under constant baseline", value: 0, baseline: []float64{4, 4, 4, 4}, want: math.Inf(-1), ok: true},
+		{name: "no deviation from constant baseline", value: 4, baseline: []float64{4, 4, 4, 4}, want: 0, ok: true},
+		{name: "empty baseline", value: 4, ok: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := RobustZScore(tt.value, tt.baseline)
+			if ok != tt.ok || (got != tt.want && math.Abs(got-tt.want) > 1e-9) {
+				t.Errorf("RobustZScore(%v, %v) = %v, %v; want %v, %v", tt.value, tt.baseline, got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package telemetry
+
+import (
+	"strconv"
+	"sync/atomic"
+)
+
+var cacheHits, cacheMisses atomic.Int64
+
+// GithubRequest records a GitHub API call; status is 0 when no response was received.
+func GithubRequest(method, endpoint string, status int) {
+	label := "error"
+	if status != 0 {
+		label = strconv.Itoa(status)
+	}
+	githubRequests.WithLabelValues(method, endpoint, label).Inc()
+}
+
+// GithubRateLimit records the rate limit reported by a GitHub response for a token fingerprint.
+func GithubRateLimit(token, resource string, limit, remaining int, reset int64) {
+	githubRateLimit.WithLabelValues(token, resource).Set(float64(limit))
+	githubRateLimitRemaining.WithLabelValues(token, resource).Set(float64(remaining))
+	githubRateLimitReset.WithLabelValues(token, resource).Set(float64(reset))
+}
+
+func CacheHit() {
+	cacheHits.Add(1)
+	cacheRequests.WithLabelValues("hit").Inc()
+}
+
+func CacheMiss() {
+	cacheMisses.Add(1)
+	cacheRequests.WithLabelValues("miss").Inc()
+}
+
+func cacheHitRatio() float64 {
+	hits, misses := cacheHits.Load(), cacheMisses.Load()
+	if hits+misses == 0 {
+		return 0
+	}
+	return float64(hits) / float64(hits+misses)
+}
@@ -0,0 +1,33 @@
+package telemetry
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+// Server is a kratos middleware recording the latency of every RPC and the errors it returns.
+func Server() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			var kind, operation string
+			if info, ok := transport.FromServerContext(ctx); ok {
+				kind = info.Kind().String()
+				operation = info.Operation()
+			}
+
+			start := time.Now()
+			reply, err := handler(ctx, req)
+			rpcDuration.WithLabelValues(kind, operation).Observe(time.Since(start).Seconds())
+
+			if se := errors.FromError(err); se != nil {
+				rpcErrors.WithLabelValues(kind, operation, strconv.Itoa(int(se.Code)), se.Reason).Inc()
+			}
+			return reply, err
+		}
+	}
+}
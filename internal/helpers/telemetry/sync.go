@@ -0,0 +1,62 @@
+package telemetry
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var syncs = &syncCollector{
+	lastSuccess: make(map[syncKey]time.Time),
+	lag: prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "sync", "lag_seconds"),
+		"Time since the last successful run of a background sync.",
+		[]string{"sync", "target"}, nil,
+	),
+}
+
+type syncKey struct {
+	sync   string
+	target string
+}
+
+// syncCollector computes the lag at scrape time so it keeps growing while a sync is failing.
+type syncCollector struct {
+	mu          sync.Mutex
+	lastSuccess map[syncKey]time.Time
+	lag         *prometheus.Desc
+}
+
+func (c *syncCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.lag
+}
+
+func (c *syncCollector) Collect(ch chan<- prometheus.Metric) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	for key, last := range c.lastSuccess {
+		ch <- prometheus.MustNewConstMetric(c.lag, prometheus.GaugeValue, now.Sub(last).Seconds(), key.sync, key.target)
+	}
+}
+
+// RegisterSync starts tracking a sync target, counting its lag from now until its first success.
+func RegisterSync(name, target string) {
+	syncs.mu.Lock()
+	defer syncs.mu.Unlock()
+
+	key := syncKey{sync: name, target: target}
+	if _, ok := syncs.lastSuccess[key]; !ok {
+		syncs.lastSuccess[key] = time.Now()
+	}
+}
+
+// SyncSucceeded resets the lag of a sync target.
+func SyncSucceeded(name, target string) {
+	syncs.mu.Lock()
+	defer syncs.mu.Unlock()
+
+	syncs.lastSuccess[syncKey{sync: name, target: target}] = time.Now()
+}
@@ -0,0 +1,93 @@
+// Package telemetry holds the operational metrics of the service itself and the registry they are
+// served from, together with the repository gauges of the exporter.
+package telemetry
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "luminex"
+
+// Registry is the registry behind the metrics endpoint.
+var Registry = prometheus.NewRegistry()
+
+var (
+	rpcDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "rpc",
+		Name:      "duration_seconds",
+		Help:      "Latency of handled RPCs.",
+		Buckets:   []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 120},
+	}, []string{"kind", "operation"})
+
+	rpcErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "rpc",
+		Name:      "errors_total",
+		Help:      "RPCs that returned an error.",
+	}, []string{"kind", "operation", "code", "reason"})
+
+	githubRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "github",
+		Name:      "requests_total",
+		Help:      "GitHub API calls by endpoint and response status.",
+	}, []string{"method", "endpoint", "status"})
+
+	githubRateLimitRemaining = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "github",
+		Name:      "rate_limit_remaining",
+		Help:      "Requests left in the current GitHub rate limit window.",
+	}, []string{"token", "resource"})
+
+	githubRateLimit = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "github",
+		Name:      "rate_limit",
+		Help:      "Requests allowed per GitHub rate limit window.",
+	}, []string{"token", "resource"})
+
+	githubRateLimitReset = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "github",
+		Name:      "rate_limit_reset_timestamp_seconds",
+		Help:      "Unix time at which the GitHub rate limit window resets.",
+	}, []string{"token", "resource"})
+
+	cacheRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "github_cache",
+		Name:      "requests_total",
+		Help:      "Cacheable GitHub API calls by cache result.",
+	}, []string{"result"})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		rpcDuration,
+		rpcErrors,
+		githubRequests,
+		githubRateLimitRemaining,
+		githubRateLimit,
+		githubRateLimitReset,
+		cacheRequests,
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "github_cache",
+			Name:      "hit_ratio",
+			Help:      "Share of cacheable GitHub API calls answered from the cache since start.",
+		}, cacheHitRatio),
+		syncs,
+	)
+}
+
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry})
+}
@@ -2,6 +2,7 @@ package server
 
 import (
 	"luminex-service/internal/conf"
+	"luminex-service/internal/helpers/telemetry"
 	"luminex-service/internal/service"
 
 	v1 "github.com/bikash-789/comm-protos/luminex/v1"
@@ -13,6 +14,8 @@ import (
 func NewGRPCServer(c *conf.Bootstrap, s *service.LuminexService, logger log.Logger) *grpc.Server {
 	var opts = []grpc.ServerOption{
 		grpc.Middleware(
+			// telemetry runs outside recovery so that panics are recorded as errors
+			telemetry.Server(),
 			recovery.Recovery(),
 		),
 	}
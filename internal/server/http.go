@@ -5,15 +5,18 @@ import (
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
 	"github.com/go-kratos/kratos/v2/transport/http"
+	"luminex-service/internal/biz/exporter"
 	"luminex-service/internal/conf"
+	"luminex-service/internal/helpers/telemetry"
 	"luminex-service/internal/service"
 )
 
-func NewHTTPServer(c *conf.Bootstrap, s *service.LuminexService, logger log.Logger) *http.Server {
+func NewHTTPServer(c *conf.Bootstrap, s *service.LuminexService, metrics *exporter.Exporter, logger log.Logger) *http.Server {
 	opts := configureServerOptions(c)
 
 	srv := http.NewServer(opts...)
 	pb.RegisterLuminexHTTPServer(srv, s)
+	srv.Handle(metrics.Path(), metrics.Handler())
 
 	return srv
 }
@@ -21,6 +24,8 @@ func NewHTTPServer(c *conf.Bootstrap, s *service.LuminexService, logger log.Logg
 func configureServerOptions(c *conf.Bootstrap) []http.ServerOption {
 	opts := []http.ServerOption{
 		http.Middleware(
+			// telemetry runs outside recovery so that panics are recorded as errors
+			telemetry.Server(),
 			recovery.Recovery(),
 		),
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/go-kratos/kratos/v2/log"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"luminex-service/internal/biz"
+	"luminex-service/internal/biz/digest"
 	gh "luminex-service/internal/biz/github"
 )
 
@@ -16,14 +17,16 @@ type LuminexService struct {
 	pb.UnimplementedLuminexServer
 	handler       biz.ILuminexServiceHandler
 	githubHandler gh.IGithubHandler
+	digests       *digest.Scheduler
 	log           *log.Helper
 }
 
-func NewLuminexService(handler biz.ILuminexServiceHandler, githubHandler gh.IGithubHandler, logger log.Logger) *LuminexService {
+func NewLuminexService(handler biz.ILuminexServiceHandler, githubHandler gh.IGithubHandler, digests *digest.Scheduler, logger log.Logger) *LuminexService {
 	return &LuminexService{
 		UnimplementedLuminexServer: pb.UnimplementedLuminexServer{},
 		handler:                    handler,
 		githubHandler:              githubHandler,
+		digests:                    digests,
 		log:                        log.NewHelper(logger),
 	}
 }
@@ -78,7 +81,7 @@ func (s *LuminexService) GetRepoStats(ctx context.Context, req *request.Reposito
 	return stats, nil
 }
 
-func (s *LuminexService) GetContributorStats(ctx context.Context, req *request.RepositoryRequest) (*response.ContributorStatsResponse, error) {
+func (s *LuminexService) GetContributorStats(ctx context.Context, req *request.ContributorStatsRequest) (*response.ContributorStatsResponse, error) {
 	s.log.WithContext(ctx).Infof("API call: GetContributorStats, repo: %s/%s", req.Owner, req.Repo)
 	stats, err := s.githubHandler.GetContributorStats(ctx, req)
 	if err != nil {
@@ -116,3 +119,225 @@ func (s *LuminexService) GetDetailedPRStats(ctx context.Context, req *request.Re
 	}
 	return stats, nil
 }
+
+func (s *LuminexService) GetTeamMembers(ctx context.Context, req *request.TeamRequest) (*response.TeamMembersResponse, error) {
+	s.log.WithContext(ctx).Infof("API call: GetTeamMembers, team: %s/%s", req.Org, req.TeamSlug)
+	members, err := s.githubHandler.GetTeamMembers(ctx, req)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("Failed to get team members: %v", err)
+		return nil, err
+	}
+	return members, nil
+}
+
+func (s *LuminexService) GetTeamMetrics(ctx context.Context, req *request.TeamMetricsRequest) (*response.TeamMetricsResponse, error) {
+	s.log.WithContext(ctx).Infof("API call: GetTeamMetrics, team: %s/%s", req.Org, req.TeamSlug)
+	metrics, err := s.githubHandler.GetTeamMetrics(ctx, req)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("Failed to get team metrics: %v", err)
+		return nil, err
+	}
+	return metrics, nil
+}
+
+func (s *LuminexService) GetReviewerWorkload(ctx context.Context, req *request.ReviewerWorkloadRequest) (*response.ReviewerWorkloadResponse, error) {
+	s.log.WithContext(ctx).Infof("API call: GetReviewerWorkload, repo: %s/%s", req.Owner, req.Repo)
+	workload, err := s.githubHandler.GetReviewerWorkload(ctx, req)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("Failed to get reviewer workload: %v", err)
+		return nil, err
+	}
+	return workload, nil
+}
+
+func (s *LuminexService) GetAtRiskPRs(ctx context.Context, req *request.RepositoryRequest) (*response.AtRiskPRsResponse, error) {
+	s.log.WithContext(ctx).Infof("API call: GetAtRiskPRs, repo: %s/%s", req.Owner, req.Repo)
+	prs, err := s.githubHandler.GetAtRiskPRs(ctx, req)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("Failed to get at-risk PRs: %v", err)
+		return nil, err
+	}
+	return prs, nil
+}
+
+func (s *LuminexService) GetCIMetrics(ctx context.Context, req *request.CIMetricsRequest) (*response.CIMetricsResponse, error) {
+	s.log.WithContext(ctx).Infof("API call: GetCIMetrics, repo: %s/%s", req.Owner, req.Repo)
+	metrics, err := s.githubHandler.GetCIMetrics(ctx, req)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("Failed to get CI metrics: %v", err)
+		return nil, err
+	}
+	return metrics, nil
+}
+
+func (s *LuminexService) GetReleaseMetrics(ctx context.Context, req *request.ReleaseMetricsRequest) (*response.ReleaseMetricsResponse, error) {
+	s.log.WithContext(ctx).Infof("API call: GetReleaseMetrics, repo: %s/%s", req.Owner, req.Repo)
+	metrics, err := s.githubHandler.GetReleaseMetrics(ctx, req)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("Failed to get release metrics: %v", err)
+		return nil, err
+	}
+	return metrics, nil
+}
+
+func (s *LuminexService) GetChangelog(ctx context.Context, req *request.ChangelogRequest) (*response.ChangelogResponse, error) {
+	s.log.WithContext(ctx).Infof("API call: GetChangelog, repo: %s/%s, range: %s...%s", req.Owner, req.Repo, req.FromTag, req.ToTag)
+	changelog, err := s.githubHandler.GetChangelog(ctx, req)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("Failed to get changelog: %v", err)
+		return nil, err
+	}
+	return changelog, nil
+}
+
+func (s *LuminexService) GetLabelBreakdown(ctx context.Context, req *request.LabelBreakdownRequest) (*response.LabelBreakdownResponse, error) {
+	s.log.WithContext(ctx).Infof("API call: GetLabelBreakdown, repo: %s/%s", req.Owner, req.Repo)
+	breakdown, err := s.githubHandler.GetLabelBreakdown(ctx, req)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("Failed to get label breakdown: %v", err)
+		return nil, err
+	}
+	return breakdown, nil
+}
+
+func (s *LuminexService) GetMilestoneProgress(ctx context.Context, req *request.MilestoneProgressRequest) (*response.MilestoneProgressResponse, error) {
+	s.log.WithContext(ctx).Infof("API call: GetMilestoneProgress, repo: %s/%s", req.Owner, req.Repo)
+	progress, err := s.githubHandler.GetMilestoneProgress(ctx, req)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("Failed to get milestone progress: %v", err)
+		return nil, err
+	}
+	return progress, nil
+}
+
+func (s *LuminexService) GetDependencyUpdates(ctx context.Context, req *request.DependencyUpdatesRequest) (*response.DependencyUpdatesResponse, error) {
+	s.log.WithContext(ctx).Infof("API call: GetDependencyUpdates, repo: %s/%s", req.Owner, req.Repo)
+	updates, err := s.githubHandler.GetDependencyUpdates(ctx, req)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("Failed to get dependency updates: %v", err)
+		return nil, err
+	}
+	return updates, nil
+}
+
+func (s *LuminexService) GetContributorRetention(ctx context.Context, req *request.ContributorRetentionRequest) (*response.ContributorRetentionResponse, error) {
+	s.log.WithContext(ctx).Infof("API call: GetContributorRetention, repo: %s/%s", req.Owner, req.Repo)
+	retention, err := s.githubHandler.GetContributorRetention(ctx, req)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("Failed to get contributor retention: %v", err)
+		return nil, err
+	}
+	return retention, nil
+}
+
+func (s *LuminexService) GetBusFactor(ctx context.Context, req *request.BusFactorRequest) (*response.BusFactorResponse, error) {
+	s.log.WithContext(ctx).Infof("API call: GetBusFactor, repo: %s/%s", req.Owner, req.Repo)
+	busFactor, err := s.githubHandler.GetBusFactor(ctx, req)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("Failed to get bus factor: %v", err)
+		return nil, err
+	}
+	return busFactor, nil
+}
+
+func (s *LuminexService) GetHotspots(ctx context.Context, req *request.HotspotsRequest) (*response.HotspotsResponse, error) {
+	s.log.WithContext(ctx).Infof("API call: GetHotspots, repo: %s/%s", req.Owner, req.Repo)
+	hotspots, err := s.githubHandler.GetHotspots(ctx, req)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("Failed to get hotspots: %v", err)
+		return nil, err
+	}
+	return hotspots, nil
+}
+
+func (s *LuminexService) GetCodeOwnersCoverage(ctx context.Context, req *request.CodeOwnersCoverageRequest) (*response.CodeOwnersCoverageResponse, error) {
+	s.log.WithContext(ctx).Infof("API call: GetCodeOwnersCoverage, repo: %s/%s", req.Owner, req.Repo)
+	coverage, err := s.githubHandler.GetCodeOwnersCoverage(ctx, req)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("Failed to get code owners coverage: %v", err)
+		return nil, err
+	}
+	return coverage, nil
+}
+
+func (s *LuminexService) GetActivityHeatmap(ctx context.Context, req *request.ActivityHeatmapRequest) (*response.ActivityHeatmapResponse, error) {
+	s.log.WithContext(ctx).Infof("API call: GetActivityHeatmap, repo: %s/%s", req.Owner, req.Repo)
+	heatmap, err := s.githubHandler.GetActivityHeatmap(ctx, req)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("Failed to get activity heatmap: %v", err)
+		return nil, err
+	}
+	return heatmap, nil
+}
+
+func (s *LuminexService) GetHygieneReport(ctx context.Context, req *request.HygieneReportRequest) (*response.HygieneReportResponse, error) {
+	s.log.WithContext(ctx).Infof("API call: GetHygieneReport, repo: %s/%s", req.Owner, req.Repo)
+	report, err := s.githubHandler.GetHygieneReport(ctx, req)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("Failed to get hygiene report: %v", err)
+		return nil, err
+	}
+	return report, nil
+}
+
+func (s *LuminexService) GetReverts(ctx context.Context, req *request.RevertsRequest) (*response.RevertsResponse, error) {
+	s.log.WithContext(ctx).Infof("API call: GetReverts, repo: %s/%s", req.Owner, req.Repo)
+	reverts, err := s.githubHandler.GetReverts(ctx, req)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("Failed to get reverts: %v", err)
+		return nil, err
+	}
+	return reverts, nil
+}
+
+func (s *LuminexService) GetAnomalies(ctx context.Context, req *request.AnomalyRequest) (*response.AnomalyResponse, error) {
+	s.log.WithContext(ctx).Infof("API call: GetAnomalies, repo: %s/%s", req.Owner, req.Repo)
+	anomalies, err := s.githubHandler.GetAnomalies(ctx, req)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("Failed to get anomalies: %v", err)
+		return nil, err
+	}
+	return anomalies, nil
+}
+
+func (s *LuminexService) GetForecast(ctx context.Context, req *request.ForecastRequest) (*response.ForecastResponse, error) {
+	s.log.WithContext(ctx).Infof("API call: GetForecast, repo: %s/%s", req.Owner, req.Repo)
+	forecast, err := s.githubHandler.GetForecast(ctx, req)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("Failed to get forecast: %v", err)
+		return nil, err
+	}
+	return forecast, nil
+}
+
+func (s *LuminexService) GetGoalStatus(ctx context.Context, req *request.GoalStatusRequest) (*response.GoalStatusResponse, error) {
+	s.log.WithContext(ctx).Infof("API call: GetGoalStatus, repo: %s/%s, team: %s", req.Owner, req.Repo, req.Team)
+	status, err := s.githubHandler.GetGoalStatus(ctx, req)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("Failed to get goal status: %v", err)
+		return nil, err
+	}
+	return status, nil
+}
+
+func (s *LuminexService) GetDigests(ctx context.Context, req *request.DigestsRequest) (*response.DigestsResponse, error) {
+	s.log.WithContext(ctx).Infof("API call: GetDigests, target: %s, limit: %d", req.Target, req.Limit)
+	digests, err := s.digests.Recent(req.Target, int(req.Limit))
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("Failed to get digests: %v", err)
+		return nil, err
+	}
+
+	result := &response.DigestsResponse{Digests: make([]*response.Digest, 0, len(digests))}
+	for _, d := range digests {
+		result.Digests = append(result.Digests, &response.Digest{
+			Target:      d.Target,
+			PeriodStart: d.PeriodStart,
+			PeriodEnd:   d.PeriodEnd,
+			GeneratedAt: d.GeneratedAt,
+			Markdown:    d.Markdown,
+			Html:        d.HTML,
+		})
+	}
+	return result, nil
+}
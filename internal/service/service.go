@@ -11,8 +11,28 @@ var ProviderSet = wire.NewSet(
 	NewLuminexService,
 	wire.Bind(new(gh.GithubHandler), new(*gh.GithubHandler)),
 	ProvideGithubConfigs,
+	ProvideAnalyticsConfig,
+	ProvideAlertingConfig,
+	ProvideDigestsConfig,
+	ProvideMetricsConfig,
 )
 
 func ProvideGithubConfigs(bootstrap *conf.Bootstrap) entity.GithubConfig {
 	return conf.GetGithubConfig(bootstrap)
 }
+
+func ProvideAnalyticsConfig(bootstrap *conf.Bootstrap) *conf.Analytics {
+	return bootstrap.GetAnalytics()
+}
+
+func ProvideAlertingConfig(bootstrap *conf.Bootstrap) *conf.Alerting {
+	return bootstrap.GetAlerting()
+}
+
+func ProvideDigestsConfig(bootstrap *conf.Bootstrap) *conf.Digests {
+	return bootstrap.GetDigests()
+}
+
+func ProvideMetricsConfig(bootstrap *conf.Bootstrap) *conf.Metrics {
+	return bootstrap.GetMetrics()
+}